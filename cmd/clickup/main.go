@@ -1,6 +1,7 @@
 package main
 
 import (
+	"errors"
 	"os"
 
 	"github.com/builtbyrobben/clickup-cli/internal/cmd"
@@ -8,6 +9,11 @@ import (
 
 func main() {
 	if err := cmd.Execute(os.Args[1:]); err != nil {
+		var exitErr *cmd.ExitError
+		if errors.As(err, &exitErr) && exitErr.Code > 0 {
+			os.Exit(exitErr.Code)
+		}
+
 		os.Exit(1)
 	}
 }
@@ -5,7 +5,9 @@ go 1.26.0
 require (
 	github.com/99designs/keyring v1.2.2
 	github.com/alecthomas/kong v1.4.0
+	github.com/itchyny/gojq v0.12.19
 	golang.org/x/term v0.18.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -14,6 +16,7 @@ require (
 	github.com/dvsekhvalnov/jose2go v1.5.0 // indirect
 	github.com/godbus/dbus v0.0.0-20190726142602-4481cbc300e2 // indirect
 	github.com/gsterjov/go-libsecret v0.0.0-20161001094733-a6f4afe4910c // indirect
+	github.com/itchyny/timefmt-go v0.1.8 // indirect
 	github.com/mtibben/percent v0.2.1 // indirect
-	golang.org/x/sys v0.18.0 // indirect
+	golang.org/x/sys v0.38.0 // indirect
 )
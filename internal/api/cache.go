@@ -0,0 +1,332 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheRule makes GET responses whose path (without query string) matches
+// Pattern cacheable for TTL. Kind groups entries for invalidation and stats.
+type CacheRule struct {
+	Kind    string
+	Pattern *regexp.Regexp
+	TTL     time.Duration
+}
+
+// InvalidateFunc returns the kinds made stale by a successful mutating
+// request, given its method and path.
+type InvalidateFunc func(method, path string) []string
+
+// Cache is an on-disk cache of GET responses stored as a single JSON file.
+// Only paths matched by a rule are cached, and successful non-GET requests
+// drop every entry of the kinds returned by the invalidation func. It is safe
+// for concurrent use within one process.
+type Cache struct {
+	path       string
+	rules      []CacheRule
+	invalidate InvalidateFunc
+	now        func() time.Time
+
+	// Refresh skips cache reads while still storing fresh responses.
+	Refresh bool
+
+	mu     sync.Mutex
+	loaded bool
+	data   cacheFile
+}
+
+type cacheFile struct {
+	Hits    int                   `json:"hits"`
+	Misses  int                   `json:"misses"`
+	Entries map[string]cacheEntry `json:"entries"`
+}
+
+type cacheEntry struct {
+	Kind        string    `json:"kind"`
+	Expires     time.Time `json:"expires"`
+	StatusCode  int       `json:"status_code"`
+	ContentType string    `json:"content_type,omitempty"`
+	Body        string    `json:"body"`
+}
+
+// CacheStats summarises the contents of a cache file. Hits and Misses are
+// counted in memory and only written along with changed entries, so they can
+// lag behind runs that were served entirely from the cache.
+type CacheStats struct {
+	Path    string
+	Entries int
+	Fresh   int
+	Expired int
+	Bytes   int64
+	Hits    int
+	Misses  int
+	ByKind  map[string]int
+}
+
+// NewCache returns a cache backed by the file at path. The file is read
+// lazily on first use and created on first write.
+func NewCache(path string, rules []CacheRule, invalidate InvalidateFunc) *Cache {
+	return &Cache{
+		path:       path,
+		rules:      rules,
+		invalidate: invalidate,
+		now:        time.Now,
+	}
+}
+
+// WithCache serves matching GET requests from c and keeps it up to date.
+func WithCache(c *Cache) ClientOption {
+	return func(cl *Client) {
+		cl.cache = c
+	}
+}
+
+// Path returns the file backing the cache.
+func (c *Cache) Path() string {
+	return c.path
+}
+
+// lookup returns a cached response for a GET request, if one is fresh.
+func (c *Cache) lookup(path string) (*http.Response, bool) {
+	rule, ok := c.rule(path)
+	if !ok {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.load()
+
+	entry, found := c.data.Entries[path]
+	if c.Refresh || !found || !c.now().Before(entry.Expires) {
+		c.data.Misses++
+
+		return nil, false
+	}
+
+	c.data.Hits++
+
+	slog.Debug("cache hit", "kind", rule.Kind, "path", path)
+
+	header := http.Header{}
+	if entry.ContentType != "" {
+		header.Set("Content-Type", entry.ContentType)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", entry.StatusCode, http.StatusText(entry.StatusCode)),
+		StatusCode:    entry.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(entry.Body)),
+		ContentLength: int64(len(entry.Body)),
+	}, true
+}
+
+// observe stores successful GET responses and applies invalidation for
+// successful mutations. A stored response's body is replaced with a buffered
+// copy.
+func (c *Cache) observe(method, path string, resp *http.Response) {
+	if resp.StatusCode >= 400 {
+		return
+	}
+
+	if method != http.MethodGet {
+		c.invalidateFor(method, path)
+		return
+	}
+
+	rule, ok := c.rule(path)
+	if !ok || resp.StatusCode != http.StatusOK {
+		return
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.load()
+	c.data.Entries[path] = cacheEntry{
+		Kind:        rule.Kind,
+		Expires:     c.now().Add(rule.TTL),
+		StatusCode:  resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+		Body:        string(body),
+	}
+	c.save()
+}
+
+func (c *Cache) invalidateFor(method, path string) {
+	if c.invalidate == nil {
+		return
+	}
+
+	kinds := c.invalidate(method, stripQuery(path))
+	if len(kinds) == 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.load()
+
+	dropped := 0
+
+	for key, entry := range c.data.Entries {
+		for _, kind := range kinds {
+			if entry.Kind == kind {
+				delete(c.data.Entries, key)
+				dropped++
+
+				break
+			}
+		}
+	}
+
+	if dropped > 0 {
+		slog.Debug("cache invalidated", "kinds", kinds, "entries", dropped)
+		c.save()
+	}
+}
+
+func (c *Cache) rule(path string) (CacheRule, bool) {
+	p := stripQuery(path)
+
+	for _, r := range c.rules {
+		if r.Pattern.MatchString(p) {
+			return r, true
+		}
+	}
+
+	return CacheRule{}, false
+}
+
+// Stats reports what the cache file currently holds.
+func (c *Cache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.load()
+
+	stats := CacheStats{
+		Path:   c.path,
+		Hits:   c.data.Hits,
+		Misses: c.data.Misses,
+		ByKind: map[string]int{},
+	}
+
+	if info, err := os.Stat(c.path); err == nil {
+		stats.Bytes = info.Size()
+	}
+
+	now := c.now()
+
+	for _, entry := range c.data.Entries {
+		stats.Entries++
+		stats.ByKind[entry.Kind]++
+
+		if now.Before(entry.Expires) {
+			stats.Fresh++
+		} else {
+			stats.Expired++
+		}
+	}
+
+	return stats
+}
+
+// load reads the cache file once. A missing or corrupt file starts an empty
+// cache. Callers must hold c.mu.
+func (c *Cache) load() {
+	if c.loaded {
+		return
+	}
+
+	c.loaded = true
+	c.data = cacheFile{Entries: map[string]cacheEntry{}}
+
+	raw, err := os.ReadFile(c.path)
+	if err != nil {
+		return
+	}
+
+	if err := json.Unmarshal(raw, &c.data); err != nil {
+		slog.Debug("ignoring unreadable cache file", "path", c.path, "error", err)
+		c.data = cacheFile{Entries: map[string]cacheEntry{}}
+	}
+
+	if c.data.Entries == nil {
+		c.data.Entries = map[string]cacheEntry{}
+	}
+}
+
+// save prunes expired entries and atomically rewrites the cache file. Write
+// failures only disable persistence; they never fail the request. Callers
+// must hold c.mu.
+func (c *Cache) save() {
+	now := c.now()
+
+	for key, entry := range c.data.Entries {
+		if !now.Before(entry.Expires) {
+			delete(c.data.Entries, key)
+		}
+	}
+
+	raw, err := json.Marshal(c.data)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o700); err != nil {
+		slog.Debug("cache write failed", "error", err)
+		return
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), ".cache-*")
+	if err != nil {
+		slog.Debug("cache write failed", "error", err)
+		return
+	}
+
+	_, writeErr := tmp.Write(raw)
+	closeErr := tmp.Close()
+
+	if writeErr != nil || closeErr != nil {
+		_ = os.Remove(tmp.Name())
+		return
+	}
+
+	if err := os.Rename(tmp.Name(), c.path); err != nil {
+		_ = os.Remove(tmp.Name())
+		slog.Debug("cache write failed", "error", err)
+	}
+}
+
+func stripQuery(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		return path[:i]
+	}
+
+	return path
+}
@@ -0,0 +1,197 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestCache(t *testing.T) *Cache {
+	t.Helper()
+
+	rules := []CacheRule{
+		{Kind: "space", Pattern: regexp.MustCompile(`^/spaces/[^/]+$`), TTL: time.Minute},
+	}
+
+	invalidate := func(_, path string) []string {
+		if regexp.MustCompile(`^/spaces/`).MatchString(path) {
+			return []string{"space"}
+		}
+
+		return nil
+	}
+
+	return NewCache(filepath.Join(t.TempDir(), "cache.json"), rules, invalidate)
+}
+
+func countingServer(t *testing.T, calls *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+
+		if r.URL.Path == "/spaces/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"err":"not found"}`))
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"call": n})
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestCache_ServesRepeatedReads(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := countingServer(t, &calls)
+	cache := newTestCache(t)
+	client := NewClient("key", WithBaseURL(server.URL), WithCache(cache))
+
+	for range 3 {
+		var result map[string]int
+		if err := client.Get(context.Background(), "/spaces/1", &result); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if result["call"] != 1 {
+			t.Fatalf("expected cached response from call 1, got %d", result["call"])
+		}
+	}
+
+	if calls.Load() != 1 {
+		t.Fatalf("expected 1 request, got %d", calls.Load())
+	}
+
+	before, err := os.ReadFile(cache.Path())
+	if err != nil {
+		t.Fatalf("read cache file: %v", err)
+	}
+
+	// A fresh cache on the same file (a new CLI invocation) reuses the entry.
+	reopened := NewCache(cache.Path(), cache.rules, cache.invalidate)
+	client = NewClient("key", WithBaseURL(server.URL), WithCache(reopened))
+
+	if err := client.Get(context.Background(), "/spaces/1", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if calls.Load() != 1 {
+		t.Fatalf("expected entry to be persisted, got %d requests", calls.Load())
+	}
+
+	// Hits are only counted in memory; they do not rewrite the file.
+	if after, _ := os.ReadFile(cache.Path()); !bytes.Equal(after, before) {
+		t.Fatal("expected a cache hit to leave the file untouched")
+	}
+
+	stats := reopened.Stats()
+	if stats.Entries != 1 || stats.Fresh != 1 || stats.Hits != 1 || stats.Misses != 1 || stats.ByKind["space"] != 1 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestCache_SkipsUnmatchedPathsAndErrors(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := countingServer(t, &calls)
+	client := NewClient("key", WithBaseURL(server.URL), WithCache(newTestCache(t)))
+
+	for range 2 {
+		_ = client.Get(context.Background(), "/tasks/1", nil)
+		_ = client.Get(context.Background(), "/spaces/missing", nil)
+	}
+
+	if calls.Load() != 4 {
+		t.Fatalf("expected every request to reach the server, got %d", calls.Load())
+	}
+}
+
+func TestCache_ExpiresAfterTTL(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := countingServer(t, &calls)
+	cache := newTestCache(t)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+	client := NewClient("key", WithBaseURL(server.URL), WithCache(cache))
+
+	_ = client.Get(context.Background(), "/spaces/1", nil)
+
+	now = now.Add(2 * time.Minute)
+
+	_ = client.Get(context.Background(), "/spaces/1", nil)
+
+	if calls.Load() != 2 {
+		t.Fatalf("expected expired entry to be refetched, got %d requests", calls.Load())
+	}
+}
+
+func TestCache_RefreshBypassesReadsButStores(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := countingServer(t, &calls)
+	cache := newTestCache(t)
+	client := NewClient("key", WithBaseURL(server.URL), WithCache(cache))
+
+	_ = client.Get(context.Background(), "/spaces/1", nil)
+
+	cache.Refresh = true
+
+	var result map[string]int
+	_ = client.Get(context.Background(), "/spaces/1", &result)
+
+	if result["call"] != 2 {
+		t.Fatalf("expected refresh to refetch, got call %d", result["call"])
+	}
+
+	cache.Refresh = false
+
+	_ = client.Get(context.Background(), "/spaces/1", &result)
+
+	if result["call"] != 2 || calls.Load() != 2 {
+		t.Fatalf("expected refreshed response to be cached, got call %d after %d requests", result["call"], calls.Load())
+	}
+}
+
+func TestCache_MutationInvalidatesKind(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := countingServer(t, &calls)
+	client := NewClient("key", WithBaseURL(server.URL), WithCache(newTestCache(t)))
+
+	_ = client.Get(context.Background(), "/spaces/1", nil)
+
+	if err := client.Put(context.Background(), "/spaces/2", map[string]string{"name": "x"}, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var result map[string]int
+	_ = client.Get(context.Background(), "/spaces/1", &result)
+
+	if result["call"] != 3 {
+		t.Fatalf("expected read after mutation to hit the server, got call %d", result["call"])
+	}
+}
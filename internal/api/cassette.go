@@ -0,0 +1,334 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// redactedValue replaces secret header values in recorded cassettes.
+const redactedValue = "REDACTED"
+
+// redactedHeaders are never written to a cassette in clear text.
+var redactedHeaders = []string{"Authorization"}
+
+// redactedBodyFields are JSON body members that are never written in clear
+// text: the OAuth token exchange sends client_secret and code and receives
+// access_token.
+var redactedBodyFields = []string{"client_secret", "code", "access_token"}
+
+// ErrNoInteraction is returned by a Replayer when no recorded interaction
+// matches a request.
+var ErrNoInteraction = errors.New("cassette: no recorded interaction")
+
+// Cassette is a recorded sequence of HTTP interactions, stored as JSON.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single recorded request/response pair.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the part of a request stored in a cassette. Path
+// includes the query string.
+type RecordedRequest struct {
+	Method  string      `json:"method"`
+	Path    string      `json:"path"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is the part of a response stored in a cassette.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// LoadCassette reads a cassette file.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // path is provided by the user
+	if err != nil {
+		return nil, fmt.Errorf("read cassette: %w", err)
+	}
+
+	var c Cassette
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("parse cassette %s: %w", path, err)
+	}
+
+	return &c, nil
+}
+
+// Save writes the cassette to path, replacing any existing file.
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode cassette: %w", err)
+	}
+
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("write cassette: %w", err)
+	}
+
+	return nil
+}
+
+// WithTransport replaces the transport of the client's underlying http.Client.
+func WithTransport(rt http.RoundTripper) ClientOption {
+	return func(c *Client) {
+		c.httpClient.Transport = rt
+	}
+}
+
+// WithRecorder records every request sent by the client to a cassette at path.
+func WithRecorder(path string) ClientOption {
+	return func(c *Client) {
+		c.httpClient.Transport = NewRecorder(path, c.httpClient.Transport)
+	}
+}
+
+// WithReplayer serves every request from a previously recorded cassette
+// instead of the network.
+func WithReplayer(r *Replayer) ClientOption {
+	return WithTransport(r)
+}
+
+// Recorder is an http.RoundTripper that forwards requests to another
+// transport and appends each exchange to a cassette file. The file is
+// rewritten after every interaction, so a partial run still leaves a usable
+// cassette behind.
+type Recorder struct {
+	path string
+	next http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder returns a Recorder writing to path. A nil next uses
+// http.DefaultTransport.
+func NewRecorder(path string, next http.RoundTripper) *Recorder {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &Recorder{path: path, next: next}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := drainBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("record request body: %w", err)
+	}
+
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := drainBody(&resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("record response body: %w", err)
+	}
+
+	interaction := Interaction{
+		Request: RecordedRequest{
+			Method:  req.Method,
+			Path:    req.URL.RequestURI(),
+			Headers: redactHeaders(req.Header),
+			Body:    string(redactBody(reqBody)),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    redactHeaders(resp.Header),
+			Body:       string(redactBody(respBody)),
+		},
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+
+	if err := r.cassette.Save(r.path); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Replayer is an http.RoundTripper that answers requests from a cassette
+// without touching the network. Requests are matched on method, path
+// (including the query string) and body. Identical requests are answered in
+// recorded order; once they are used up, the last match is repeated.
+type Replayer struct {
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// NewReplayer loads the cassette at path for replay.
+func NewReplayer(path string) (*Replayer, error) {
+	c, err := LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Replayer{cassette: c, used: make([]bool, len(c.Interactions))}, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := drainBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read request body: %w", err)
+	}
+
+	path := req.URL.RequestURI()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	last := -1
+
+	for i, in := range r.cassette.Interactions {
+		if !matchInteraction(in.Request, req, path, body) {
+			continue
+		}
+
+		last = i
+
+		if !r.used[i] {
+			r.used[i] = true
+			return in.Response.toHTTP(req), nil
+		}
+	}
+
+	if last >= 0 {
+		return r.cassette.Interactions[last].Response.toHTTP(req), nil
+	}
+
+	return nil, fmt.Errorf("%w for %s %s", ErrNoInteraction, req.Method, path)
+}
+
+func matchInteraction(rec RecordedRequest, req *http.Request, path string, body []byte) bool {
+	if rec.Method != req.Method || rec.Path != path {
+		return false
+	}
+
+	// Multipart bodies embed a random boundary, so they can never match
+	// byte for byte.
+	if mediaType, _, err := mime.ParseMediaType(req.Header.Get("Content-Type")); err == nil &&
+		strings.HasPrefix(mediaType, "multipart/") {
+		return true
+	}
+
+	// Recorded secrets are redacted, so a live token exchange only matches
+	// once its own secrets are.
+	return equalBodies([]byte(rec.Body), body) ||
+		equalBodies(redactBody([]byte(rec.Body)), redactBody(body))
+}
+
+// equalBodies compares two bodies, ignoring insignificant whitespace in JSON.
+func equalBodies(a, b []byte) bool {
+	if bytes.Equal(a, b) {
+		return true
+	}
+
+	var ca, cb bytes.Buffer
+	if json.Compact(&ca, a) != nil || json.Compact(&cb, b) != nil {
+		return false
+	}
+
+	return bytes.Equal(ca.Bytes(), cb.Bytes())
+}
+
+func (r RecordedResponse) toHTTP(req *http.Request) *http.Response {
+	header := r.Headers.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
+
+// drainBody reads *body fully and replaces it with an equivalent reader.
+func drainBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	data, err := io.ReadAll(*body)
+	_ = (*body).Close()
+
+	*body = io.NopCloser(bytes.NewReader(data))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func redactHeaders(h http.Header) http.Header {
+	out := h.Clone()
+
+	for _, name := range redactedHeaders {
+		if out.Get(name) != "" {
+			out.Set(name, redactedValue)
+		}
+	}
+
+	return out
+}
+
+// redactBody masks redactedBodyFields in a JSON object body. Any other body is
+// returned unchanged.
+func redactBody(body []byte) []byte {
+	var obj map[string]json.RawMessage
+	if json.Unmarshal(body, &obj) != nil {
+		return body
+	}
+
+	found := false
+
+	for _, name := range redactedBodyFields {
+		if _, ok := obj[name]; ok {
+			obj[name] = json.RawMessage(`"` + redactedValue + `"`)
+			found = true
+		}
+	}
+
+	if !found {
+		return body
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return body
+	}
+
+	return data
+}
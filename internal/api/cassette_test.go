@@ -0,0 +1,231 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecorder_WritesRedactedCassette(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"method": r.Method, "echo": string(body)})
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	client := NewClient("secret-token", WithBaseURL(server.URL), WithRecorder(path))
+
+	var result map[string]string
+	if err := client.Post(context.Background(), "/tasks?x=1", map[string]string{"name": "A"}, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result["echo"] != `{"name":"A"}` {
+		t.Fatalf("expected recorder to pass the body through, got %q", result["echo"])
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read cassette: %v", err)
+	}
+
+	if strings.Contains(string(raw), "secret-token") {
+		t.Fatal("expected Authorization header to be redacted")
+	}
+
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		t.Fatalf("load cassette: %v", err)
+	}
+
+	if len(cassette.Interactions) != 1 {
+		t.Fatalf("expected 1 interaction, got %d", len(cassette.Interactions))
+	}
+
+	in := cassette.Interactions[0]
+	if in.Request.Method != http.MethodPost || in.Request.Path != "/tasks?x=1" {
+		t.Fatalf("unexpected recorded request: %+v", in.Request)
+	}
+
+	if in.Request.Headers.Get("Authorization") != redactedValue {
+		t.Fatalf("expected redacted Authorization, got %q", in.Request.Headers.Get("Authorization"))
+	}
+
+	if in.Response.StatusCode != http.StatusOK || !strings.Contains(in.Response.Body, `"method":"POST"`) {
+		t.Fatalf("unexpected recorded response: %+v", in.Response)
+	}
+}
+
+func TestReplayer_ServesRecordedResponsesOffline(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	cassette := &Cassette{Interactions: []Interaction{
+		{
+			Request:  RecordedRequest{Method: http.MethodGet, Path: "/tasks/1"},
+			Response: RecordedResponse{StatusCode: http.StatusOK, Body: `{"id":"first"}`},
+		},
+		{
+			Request:  RecordedRequest{Method: http.MethodGet, Path: "/tasks/1"},
+			Response: RecordedResponse{StatusCode: http.StatusOK, Body: `{"id":"second"}`},
+		},
+		{
+			Request:  RecordedRequest{Method: http.MethodPut, Path: "/tasks/1", Body: `{"name": "B"}`},
+			Response: RecordedResponse{StatusCode: http.StatusNotFound, Body: `{"err":"Task not found"}`},
+		},
+	}}
+
+	if err := cassette.Save(path); err != nil {
+		t.Fatalf("save cassette: %v", err)
+	}
+
+	replayer, err := NewReplayer(path)
+	if err != nil {
+		t.Fatalf("load replayer: %v", err)
+	}
+
+	client := NewClient("", WithBaseURL("http://cassette.invalid"), WithReplayer(replayer))
+
+	var got []string
+
+	for range 3 {
+		var result struct {
+			ID string `json:"id"`
+		}
+
+		if err := client.Get(context.Background(), "/tasks/1", &result); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got = append(got, result.ID)
+	}
+
+	if strings.Join(got, ",") != "first,second,second" {
+		t.Fatalf("expected recorded order then repeat of last match, got %v", got)
+	}
+
+	err = client.Put(context.Background(), "/tasks/1", map[string]string{"name": "B"}, nil)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected recorded 404, got %v", err)
+	}
+}
+
+func TestReplayer_UnmatchedRequestFails(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	cassette := &Cassette{Interactions: []Interaction{{
+		Request:  RecordedRequest{Method: http.MethodPost, Path: "/tasks", Body: `{"name":"A"}`},
+		Response: RecordedResponse{StatusCode: http.StatusOK, Body: `{}`},
+	}}}
+
+	if err := cassette.Save(path); err != nil {
+		t.Fatalf("save cassette: %v", err)
+	}
+
+	replayer, err := NewReplayer(path)
+	if err != nil {
+		t.Fatalf("load replayer: %v", err)
+	}
+
+	client := NewClient("", WithBaseURL("http://cassette.invalid"), WithReplayer(replayer))
+
+	err = client.Post(context.Background(), "/tasks", map[string]string{"name": "other"}, nil)
+	if !errors.Is(err, ErrNoInteraction) {
+		t.Fatalf("expected ErrNoInteraction for a different body, got %v", err)
+	}
+}
+
+func TestRecordThenReplay_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"path": r.URL.Path})
+	}))
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	recording := NewClient("token", WithBaseURL(server.URL), WithRecorder(path))
+
+	if err := recording.Get(context.Background(), "/lists/9", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Close()
+
+	replayer, err := NewReplayer(path)
+	if err != nil {
+		t.Fatalf("load replayer: %v", err)
+	}
+
+	replaying := NewClient("", WithBaseURL(server.URL), WithReplayer(replayer))
+
+	var result map[string]string
+	if err := replaying.Get(context.Background(), "/lists/9", &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result["path"] != "/lists/9" {
+		t.Fatalf("expected replayed body, got %v", result)
+	}
+}
+
+func TestRecordThenReplay_RedactsTokenExchange(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"live-access-token","token_type":"Bearer"}`))
+	}))
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	recording := NewClient("", WithBaseURL(server.URL), WithRecorder(path))
+	req := map[string]string{"client_id": "app", "client_secret": "live-secret", "code": "live-code"}
+
+	if err := recording.Post(context.Background(), "/oauth/token", req, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Close()
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read cassette: %v", err)
+	}
+
+	for _, secret := range []string{"live-secret", "live-code", "live-access-token"} {
+		if strings.Contains(string(raw), secret) {
+			t.Fatalf("expected %q to be redacted, got %s", secret, raw)
+		}
+	}
+
+	replayer, err := NewReplayer(path)
+	if err != nil {
+		t.Fatalf("load replayer: %v", err)
+	}
+
+	replaying := NewClient("", WithBaseURL(server.URL), WithReplayer(replayer))
+
+	var result map[string]string
+	if err := replaying.Post(context.Background(), "/oauth/token", req, &result); err != nil {
+		t.Fatalf("expected the redacted exchange to replay, got %v", err)
+	}
+
+	if result["access_token"] != redactedValue || result["token_type"] != "Bearer" {
+		t.Fatalf("unexpected replayed token: %v", result)
+	}
+}
@@ -4,12 +4,15 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"mime/multipart"
 	"net/http"
 	"net/textproto"
 	"path/filepath"
+	"strings"
 	"time"
 )
 
@@ -17,7 +20,12 @@ type Client struct {
 	httpClient *http.Client
 	baseURL    string
 	apiKey     string
+	bearer     bool
 	userAgent  string
+	retry      retryPolicy
+	limiter    *RateLimiter
+	cache      *Cache
+	dryRun     DryRunFunc
 }
 
 type ClientOption func(*Client)
@@ -34,6 +42,14 @@ func WithUserAgent(ua string) ClientOption {
 	}
 }
 
+// WithBearerAuth sends the key as "Authorization: Bearer <key>", as required
+// for OAuth access tokens. Personal API tokens are sent without a scheme.
+func WithBearerAuth() ClientOption {
+	return func(c *Client) {
+		c.bearer = true
+	}
+}
+
 func WithTimeout(timeout time.Duration) ClientOption {
 	return func(c *Client) {
 		c.httpClient.Timeout = timeout
@@ -48,6 +64,11 @@ func NewClient(apiKey string, opts ...ClientOption) *Client {
 		apiKey:    apiKey,
 		userAgent: "clickup-cli/1.0",
 		baseURL:   "https://api.clickup.com/api",
+		retry: retryPolicy{
+			baseDelay: defaultRetryBaseDelay,
+			maxWait:   defaultRetryMaxWait,
+		},
+		limiter: NewRateLimiter(),
 	}
 
 	for _, opt := range opts {
@@ -65,7 +86,7 @@ type Request struct {
 }
 
 func (c *Client) Do(ctx context.Context, req Request) (*http.Response, error) {
-	var bodyReader io.Reader
+	var body []byte
 
 	if req.Body != nil {
 		bodyBytes, err := json.Marshal(req.Body)
@@ -73,36 +94,127 @@ func (c *Client) Do(ctx context.Context, req Request) (*http.Response, error) {
 			return nil, fmt.Errorf("marshal request body: %w", err)
 		}
 
-		bodyReader = bytes.NewReader(bodyBytes)
+		body = bodyBytes
 	}
 
-	url := c.baseURL + req.Path
+	if err := c.checkDryRun(req.Method, req.Path, body, ""); err != nil {
+		return nil, err
+	}
 
-	httpReq, err := http.NewRequestWithContext(ctx, req.Method, url, bodyReader)
-	if err != nil {
-		return nil, fmt.Errorf("create request: %w", err)
+	return c.send(ctx, req.Method, req.Path, body, "application/json", req.Headers)
+}
+
+// send executes a request through the client's cache (if any), retrying
+// according to the client's retry policy.
+func (c *Client) send(ctx context.Context, method, path string, body []byte, contentType string, headers map[string]string) (*http.Response, error) {
+	if c.cache == nil {
+		return c.sendWithRetry(ctx, method, path, body, contentType, headers)
 	}
 
-	// Set default headers
-	httpReq.Header.Set("Content-Type", "application/json")
-	httpReq.Header.Set("User-Agent", c.userAgent)
+	if method == http.MethodGet {
+		if resp, ok := c.cache.lookup(path); ok {
+			return resp, nil
+		}
+	}
 
-	// ClickUp uses Authorization: <key> (no Bearer prefix)
-	if c.apiKey != "" {
-		httpReq.Header.Set("Authorization", c.apiKey)
+	resp, err := c.sendWithRetry(ctx, method, path, body, contentType, headers)
+	if err == nil {
+		c.cache.observe(method, path, resp)
 	}
 
-	// Set custom headers
-	for key, value := range req.Headers {
-		httpReq.Header.Set(key, value)
+	return resp, err
+}
+
+// sendWithRetry executes a request, retrying according to the client's retry
+// policy. The body is buffered so it can be replayed on every attempt.
+func (c *Client) sendWithRetry(ctx context.Context, method, path string, body []byte, contentType string, headers map[string]string) (*http.Response, error) {
+	url := c.baseURL + path
+
+	for attempt := 0; ; attempt++ {
+		var bodyReader io.Reader
+		if body != nil {
+			bodyReader = bytes.NewReader(body)
+		}
+
+		httpReq, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+		if err != nil {
+			return nil, fmt.Errorf("create request: %w", err)
+		}
+
+		// Set default headers
+		httpReq.Header.Set("Content-Type", contentType)
+		httpReq.Header.Set("User-Agent", c.userAgent)
+
+		// ClickUp uses Authorization: <key> (no Bearer prefix) for personal
+		// tokens and Bearer for OAuth tokens
+		switch {
+		case c.apiKey == "":
+		case c.bearer:
+			httpReq.Header.Set("Authorization", "Bearer "+c.apiKey)
+		default:
+			httpReq.Header.Set("Authorization", c.apiKey)
+		}
+
+		// Set custom headers
+		for key, value := range headers {
+			httpReq.Header.Set(key, value)
+		}
+
+		if c.limiter != nil {
+			if err := c.limiter.Wait(ctx); err != nil {
+				return nil, fmt.Errorf("execute request: %w", err)
+			}
+		}
+
+		resp, err := c.httpClient.Do(httpReq)
+		if err == nil && c.limiter != nil {
+			c.limiter.Update(resp.Header)
+			c.logRateLimit()
+		}
+
+		if attempt >= c.retry.maxRetries || ctx.Err() != nil || !shouldRetry(method, resp, err) {
+			if err != nil {
+				return nil, fmt.Errorf("execute request: %w", err)
+			}
+
+			return resp, nil
+		}
+
+		wait := c.retry.delay(attempt, resp, time.Now())
+
+		status := 0
+		if resp != nil {
+			status = resp.StatusCode
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		slog.Debug("retrying request",
+			"method", method,
+			"path", path,
+			"attempt", attempt+1,
+			"status", status,
+			"wait", wait,
+		)
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, fmt.Errorf("execute request: %w", err)
+		}
 	}
+}
 
-	resp, err := c.httpClient.Do(httpReq)
-	if err != nil {
-		return nil, fmt.Errorf("execute request: %w", err)
+// logRateLimit reports the current budget at debug level (visible with --verbose).
+func (c *Client) logRateLimit() {
+	budget := c.limiter.Budget()
+	if !budget.Known {
+		return
 	}
 
-	return resp, nil
+	slog.Debug("rate limit",
+		"remaining", budget.Remaining,
+		"limit", budget.Limit,
+		"reset", budget.Reset.Format(time.RFC3339),
+	)
 }
 
 func (c *Client) Get(ctx context.Context, path string, result any) error {
@@ -122,32 +234,12 @@ func (c *Client) Patch(ctx context.Context, path string, body, result any) error
 }
 
 func (c *Client) Delete(ctx context.Context, path string) error {
-	resp, err := c.Do(ctx, Request{Method: http.MethodDelete, Path: path})
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode >= 400 {
-		return parseAPIError(resp)
-	}
-
-	return nil
+	return c.doJSON(ctx, Request{Method: http.MethodDelete, Path: path}, nil)
 }
 
 // DeleteWithBody sends a DELETE request with a JSON body.
 func (c *Client) DeleteWithBody(ctx context.Context, path string, body any) error {
-	resp, err := c.Do(ctx, Request{Method: http.MethodDelete, Path: path, Body: body})
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode >= 400 {
-		return parseAPIError(resp)
-	}
-
-	return nil
+	return c.doJSON(ctx, Request{Method: http.MethodDelete, Path: path, Body: body}, nil)
 }
 
 // PostMultipart sends a multipart/form-data POST request for file uploads.
@@ -175,38 +267,16 @@ func (c *Client) PostMultipart(ctx context.Context, path string, fieldName strin
 		return fmt.Errorf("close multipart writer: %w", closeErr)
 	}
 
-	url := c.baseURL + path
-
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, &body)
-	if err != nil {
-		return fmt.Errorf("create request: %w", err)
-	}
-
-	// Set the Content-Type header with the boundary
-	httpReq.Header.Set("Content-Type", writer.FormDataContentType())
-	httpReq.Header.Set("User-Agent", c.userAgent)
-
-	if c.apiKey != "" {
-		httpReq.Header.Set("Authorization", c.apiKey)
+	if err := c.checkDryRun(http.MethodPost, path, nil, fileName); err != nil {
+		return err
 	}
 
-	resp, err := c.httpClient.Do(httpReq)
+	resp, err := c.send(ctx, http.MethodPost, path, body.Bytes(), writer.FormDataContentType(), nil)
 	if err != nil {
-		return fmt.Errorf("execute request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode >= 400 {
-		return parseAPIError(resp)
-	}
-
-	if result != nil {
-		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
-			return fmt.Errorf("decode response: %w", err)
-		}
+		return err
 	}
 
-	return nil
+	return decodeResponse(resp, result)
 }
 
 func (c *Client) doJSON(ctx context.Context, req Request, result any) error {
@@ -214,6 +284,13 @@ func (c *Client) doJSON(ctx context.Context, req Request, result any) error {
 	if err != nil {
 		return err
 	}
+
+	return decodeResponse(resp, result)
+}
+
+// decodeResponse closes the response body after turning error statuses into
+// *APIError and decoding successful JSON bodies into result (if non-nil).
+func decodeResponse(resp *http.Response, result any) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode >= 400 {
@@ -229,38 +306,107 @@ func (c *Client) doJSON(ctx context.Context, req Request, result any) error {
 	return nil
 }
 
+// Sentinel errors for the failure classes callers usually need to branch on.
+// An *APIError matches the sentinel for its class with errors.Is.
+var (
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrPlanLimit    = errors.New("plan limit reached")
+)
+
+// requestIDHeaders are checked in order for an ID to quote in bug reports.
+var requestIDHeaders = []string{"X-Request-Id", "X-Amzn-Requestid", "X-Amz-Cf-Id"}
+
+// APIError is a non-2xx response from the API. Code holds ClickUp's ECODE
+// (e.g. "OAUTH_017") when the body carries one.
 type APIError struct {
 	StatusCode int
 	Message    string
+	Code       string
+	RequestID  string
+	Body       []byte
 }
 
 func (e *APIError) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("API error (%d %s): %s", e.StatusCode, e.Code, e.Message)
+	}
+
 	return fmt.Sprintf("API error (%d): %s", e.StatusCode, e.Message)
 }
 
+// Is reports whether target is the sentinel for this error's class.
+func (e *APIError) Is(target error) bool {
+	return target != nil && e.Class() == target
+}
+
+// Class returns the sentinel error for this failure, or nil if it does not
+// fall into one of the known classes.
+func (e *APIError) Class() error {
+	switch {
+	case e.StatusCode == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case e.StatusCode == http.StatusPaymentRequired || e.isPlanLimit():
+		return ErrPlanLimit
+	case e.StatusCode == http.StatusUnauthorized || strings.HasPrefix(e.Code, "OAUTH_"):
+		return ErrUnauthorized
+	case e.StatusCode == http.StatusForbidden:
+		return ErrForbidden
+	case e.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	default:
+		return nil
+	}
+}
+
+// isPlanLimit recognises ClickUp's plan restriction errors, which come back
+// as 400/403 responses whose message asks the user to upgrade.
+func (e *APIError) isPlanLimit() bool {
+	if e.StatusCode < 400 || e.StatusCode >= 500 {
+		return false
+	}
+
+	msg := strings.ToLower(e.Message)
+
+	return strings.Contains(msg, "upgrade") || (strings.Contains(msg, "plan") && strings.Contains(msg, "limit"))
+}
+
 func parseAPIError(resp *http.Response) error {
 	body, _ := io.ReadAll(resp.Body)
 
-	var apiErr struct {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Message:    http.StatusText(resp.StatusCode),
+		Body:       body,
+	}
+
+	for _, name := range requestIDHeaders {
+		if id := resp.Header.Get(name); id != "" {
+			apiErr.RequestID = id
+			break
+		}
+	}
+
+	var payload struct {
+		Err     string `json:"err"`
 		Message string `json:"message"`
 		Error   string `json:"error"`
+		ECODE   string `json:"ECODE"` //nolint:tagliatelle // ClickUp's field name
 	}
 
-	// Try to parse as JSON
-	if json.Unmarshal(body, &apiErr) == nil {
-		msg := apiErr.Message
-		if msg == "" {
-			msg = apiErr.Error
+	// Try to parse as JSON; otherwise keep the status text
+	if json.Unmarshal(body, &payload) == nil {
+		for _, msg := range []string{payload.Err, payload.Message, payload.Error} {
+			if msg != "" {
+				apiErr.Message = msg
+				break
+			}
 		}
 
-		if msg != "" {
-			return &APIError{StatusCode: resp.StatusCode, Message: msg}
-		}
+		apiErr.Code = payload.ECODE
 	}
 
-	// Fallback to status text
-	return &APIError{
-		StatusCode: resp.StatusCode,
-		Message:    http.StatusText(resp.StatusCode),
-	}
+	return apiErr
 }
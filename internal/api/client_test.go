@@ -376,3 +376,89 @@ func TestBaseURLConstruction(t *testing.T) {
 		t.Fatalf("expected base URL https://api.clickup.com/api, got %s", expectedBaseURL)
 	}
 }
+
+func TestGet_ParsesClickUpErrorBody(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"err":"Token invalid","ECODE":"OAUTH_025"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	err := client.Get(context.Background(), "/tasks/1", nil)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T", err)
+	}
+
+	if apiErr.Message != "Token invalid" || apiErr.Code != "OAUTH_025" || apiErr.RequestID != "req-123" {
+		t.Fatalf("unexpected error fields: %+v", apiErr)
+	}
+
+	if string(apiErr.Body) != `{"err":"Token invalid","ECODE":"OAUTH_025"}` {
+		t.Fatalf("expected raw body to be kept, got %s", apiErr.Body)
+	}
+
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("expected errors.Is(err, ErrUnauthorized), got %v", err)
+	}
+
+	if err.Error() != "API error (401 OAUTH_025): Token invalid" {
+		t.Fatalf("unexpected error string: %s", err.Error())
+	}
+}
+
+func TestAPIError_Class(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  *APIError
+		want error
+	}{
+		{"unauthorized status", &APIError{StatusCode: 401, Message: "Token invalid"}, ErrUnauthorized},
+		{"oauth ecode", &APIError{StatusCode: 400, Code: "OAUTH_017", Message: "Authorization header required"}, ErrUnauthorized},
+		{"forbidden", &APIError{StatusCode: 403, Message: "Forbidden"}, ErrForbidden},
+		{"not found", &APIError{StatusCode: 404, Message: "Task not found"}, ErrNotFound},
+		{"rate limited", &APIError{StatusCode: 429, Message: "Rate limit reached"}, ErrRateLimited},
+		{"payment required", &APIError{StatusCode: 402, Message: "Payment Required"}, ErrPlanLimit},
+		{"upgrade message", &APIError{StatusCode: 403, Message: "Please upgrade to use more custom fields"}, ErrPlanLimit},
+		{"plan limit message", &APIError{StatusCode: 400, Message: "Plan limit exceeded"}, ErrPlanLimit},
+		{"server error", &APIError{StatusCode: 500, Message: "Upgrade in progress"}, nil},
+		{"bad request", &APIError{StatusCode: 400, Message: "Invalid status"}, nil},
+	}
+
+	for _, tt := range tests {
+		if got := tt.err.Class(); got != tt.want { //nolint:errorlint // comparing sentinels
+			t.Fatalf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+
+		if tt.want != nil && !errors.Is(tt.err, tt.want) {
+			t.Fatalf("%s: expected errors.Is to match %v", tt.name, tt.want)
+		}
+	}
+}
+
+func TestGet_BearerAuthForOAuthTokens(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer oauth-token" {
+			t.Fatalf("expected Authorization Bearer oauth-token, got %s", r.Header.Get("Authorization"))
+		}
+
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("oauth-token", WithBaseURL(server.URL), WithBearerAuth())
+
+	if err := client.Get(context.Background(), "/user", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
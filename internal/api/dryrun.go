@@ -0,0 +1,47 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// ErrDryRun is returned instead of sending a non-GET request in dry-run mode.
+var ErrDryRun = errors.New("dry run: request not sent")
+
+// DryRunRequest describes a request that dry-run mode did not send.
+type DryRunRequest struct {
+	Method string
+	URL    string
+	// Body is the JSON body, if any.
+	Body json.RawMessage
+	// FileName is set for multipart uploads instead of Body.
+	FileName string
+}
+
+// DryRunFunc receives each request suppressed by dry-run mode.
+type DryRunFunc func(DryRunRequest)
+
+// WithDryRun makes the client hand every non-GET request to fn and fail it
+// with ErrDryRun instead of sending it. GET requests still go out, so lookups
+// keep working.
+func WithDryRun(fn DryRunFunc) ClientOption {
+	return func(c *Client) {
+		c.dryRun = fn
+	}
+}
+
+func (c *Client) checkDryRun(method, path string, body []byte, fileName string) error {
+	if c.dryRun == nil || method == http.MethodGet {
+		return nil
+	}
+
+	c.dryRun(DryRunRequest{
+		Method:   method,
+		URL:      c.baseURL + path,
+		Body:     json.RawMessage(body),
+		FileName: fileName,
+	})
+
+	return ErrDryRun
+}
@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestDryRun_SuppressesMutations(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var seen []DryRunRequest
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL), WithDryRun(func(req DryRunRequest) {
+		seen = append(seen, req)
+	}))
+
+	if err := client.Get(context.Background(), "/tasks/1", nil); err != nil {
+		t.Fatalf("expected GET to run, got %v", err)
+	}
+
+	err := client.Post(context.Background(), "/tasks", map[string]string{"name": "A"}, nil)
+	if !errors.Is(err, ErrDryRun) {
+		t.Fatalf("expected ErrDryRun, got %v", err)
+	}
+
+	err = client.PostMultipart(context.Background(), "/tasks/1/attachment", "attachment", strings.NewReader("data"), "/tmp/report.pdf", nil)
+	if !errors.Is(err, ErrDryRun) {
+		t.Fatalf("expected ErrDryRun for multipart, got %v", err)
+	}
+
+	if calls.Load() != 1 {
+		t.Fatalf("expected only the GET to reach the server, got %d requests", calls.Load())
+	}
+
+	if len(seen) != 2 {
+		t.Fatalf("expected 2 suppressed requests, got %d", len(seen))
+	}
+
+	if seen[0].Method != http.MethodPost || seen[0].URL != server.URL+"/tasks" || string(seen[0].Body) != `{"name":"A"}` {
+		t.Fatalf("unexpected dry-run request: %+v", seen[0])
+	}
+
+	if seen[1].FileName != "/tmp/report.pdf" || len(seen[1].Body) != 0 {
+		t.Fatalf("unexpected multipart dry-run request: %+v", seen[1])
+	}
+}
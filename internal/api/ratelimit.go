@@ -0,0 +1,159 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// rateLimitLowWater is the fraction of the per-window limit below which the
+// limiter starts spacing requests out instead of letting them through at once.
+const rateLimitLowWater = 0.1
+
+// RateLimitBudget is a snapshot of the request budget reported by ClickUp.
+// Known is false until a response carrying X-RateLimit-* headers has been seen
+// in the current window.
+type RateLimitBudget struct {
+	Known     bool
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// RateLimiter tracks ClickUp's X-RateLimit-* headers and slows callers down
+// before the budget runs out. It is safe for concurrent use, so one limiter
+// can be shared by every goroutine (or client) using the same token.
+type RateLimiter struct {
+	mu        sync.Mutex
+	known     bool
+	limit     int
+	remaining int
+	reset     time.Time
+	next      time.Time
+	now       func() time.Time
+}
+
+// NewRateLimiter returns a limiter with no known budget.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{now: time.Now}
+}
+
+// WithRateLimiter replaces the client's rate limiter. Pass a shared limiter to
+// pool the budget across clients, or nil to disable client-side limiting.
+func WithRateLimiter(l *RateLimiter) ClientOption {
+	return func(c *Client) {
+		c.limiter = l
+	}
+}
+
+// RateLimit returns the client's current view of the rate limit budget.
+func (c *Client) RateLimit() RateLimitBudget {
+	if c.limiter == nil {
+		return RateLimitBudget{}
+	}
+
+	return c.limiter.Budget()
+}
+
+// Budget returns a snapshot of the current budget.
+func (l *RateLimiter) Budget() RateLimitBudget {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.known || !l.now().Before(l.reset) {
+		return RateLimitBudget{}
+	}
+
+	return RateLimitBudget{
+		Known:     true,
+		Limit:     l.limit,
+		Remaining: l.remaining,
+		Reset:     l.reset,
+	}
+}
+
+// Update records the budget advertised by a response. Headers without a
+// remaining count and reset time are ignored.
+func (l *RateLimiter) Update(h http.Header) {
+	remaining, err := strconv.Atoi(h.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+
+	resetEpoch, err := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+
+	reset := time.Unix(resetEpoch, 0)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Concurrent responses can arrive out of order; within one window the
+	// lowest count is the most recent one.
+	if l.known && reset.Equal(l.reset) && remaining > l.remaining {
+		remaining = l.remaining
+	}
+
+	if limit, err := strconv.Atoi(h.Get("X-RateLimit-Limit")); err == nil {
+		l.limit = limit
+	}
+
+	l.known = true
+	l.remaining = remaining
+	l.reset = reset
+}
+
+// Wait blocks until the caller may send a request, or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	d := l.reserve()
+	if d <= 0 {
+		return nil
+	}
+
+	slog.Debug("rate limit: throttling request", "wait", d)
+
+	return sleepContext(ctx, d)
+}
+
+// reserve claims one request from the budget and returns how long the caller
+// must wait before sending it. Once the budget is low, requests are spread
+// evenly over the rest of the window; once it is exhausted, callers wait for
+// the reset.
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if !l.known || !now.Before(l.reset) {
+		// The window rolled over; the next response tells us the new budget.
+		l.known = false
+		return 0
+	}
+
+	start := now
+	if l.next.After(start) {
+		start = l.next
+	}
+
+	if l.remaining <= 0 {
+		if l.reset.After(start) {
+			start = l.reset
+		}
+
+		return start.Sub(now)
+	}
+
+	lowWater := max(1, int(float64(l.limit)*rateLimitLowWater))
+	if l.remaining <= lowWater {
+		l.next = start.Add(l.reset.Sub(now) / time.Duration(l.remaining+1))
+	}
+
+	l.remaining--
+
+	return start.Sub(now)
+}
@@ -0,0 +1,218 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func rateLimitHeaders(limit, remaining int, reset time.Time) http.Header {
+	h := http.Header{}
+	h.Set("X-RateLimit-Limit", strconv.Itoa(limit))
+	h.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	h.Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+
+	return h
+}
+
+func newTestLimiter(now time.Time) *RateLimiter {
+	l := NewRateLimiter()
+	l.now = func() time.Time { return now }
+
+	return l
+}
+
+func TestRateLimiter_UpdateTracksBudget(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_000_000, 0)
+	reset := now.Add(time.Minute)
+	l := newTestLimiter(now)
+
+	if l.Budget().Known {
+		t.Fatal("expected unknown budget before any response")
+	}
+
+	l.Update(rateLimitHeaders(100, 42, reset))
+
+	budget := l.Budget()
+	if !budget.Known || budget.Limit != 100 || budget.Remaining != 42 || !budget.Reset.Equal(reset) {
+		t.Fatalf("unexpected budget: %+v", budget)
+	}
+
+	// An out-of-order response from the same window must not raise the count.
+	l.Update(rateLimitHeaders(100, 50, reset))
+
+	if got := l.Budget().Remaining; got != 42 {
+		t.Fatalf("expected remaining to stay 42, got %d", got)
+	}
+
+	// A new window resets the count.
+	l.Update(rateLimitHeaders(100, 99, reset.Add(time.Minute)))
+
+	if got := l.Budget().Remaining; got != 99 {
+		t.Fatalf("expected remaining 99 in new window, got %d", got)
+	}
+}
+
+func TestRateLimiter_IgnoresMissingHeaders(t *testing.T) {
+	t.Parallel()
+
+	l := NewRateLimiter()
+	l.Update(http.Header{"X-Ratelimit-Remaining": {"10"}})
+
+	if l.Budget().Known {
+		t.Fatal("expected budget to stay unknown without a reset header")
+	}
+}
+
+func TestRateLimiter_NoDelayWithHealthyBudget(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_000_000, 0)
+	l := newTestLimiter(now)
+	l.Update(rateLimitHeaders(100, 80, now.Add(time.Minute)))
+
+	for range 10 {
+		if d := l.reserve(); d != 0 {
+			t.Fatalf("expected no delay, got %v", d)
+		}
+	}
+
+	if got := l.Budget().Remaining; got != 70 {
+		t.Fatalf("expected reservations to consume budget, got remaining %d", got)
+	}
+}
+
+func TestRateLimiter_SpreadsRequestsWhenBudgetLow(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_000_000, 0)
+	l := newTestLimiter(now)
+	l.Update(rateLimitHeaders(100, 3, now.Add(40*time.Second)))
+
+	var delays []time.Duration
+	for range 3 {
+		delays = append(delays, l.reserve())
+	}
+
+	if delays[0] != 0 {
+		t.Fatalf("expected first request to go immediately, got %v", delays[0])
+	}
+
+	for i := 1; i < len(delays); i++ {
+		if delays[i] <= delays[i-1] {
+			t.Fatalf("expected increasing delays, got %v", delays)
+		}
+	}
+}
+
+func TestRateLimiter_WaitsForResetWhenExhausted(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_000_000, 0)
+	reset := now.Add(15 * time.Second)
+	l := newTestLimiter(now)
+	l.Update(rateLimitHeaders(100, 0, reset))
+
+	if d := l.reserve(); d != 15*time.Second {
+		t.Fatalf("expected to wait until reset, got %v", d)
+	}
+}
+
+func TestRateLimiter_ForgetsExpiredWindow(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_000_000, 0)
+	l := newTestLimiter(now)
+	l.Update(rateLimitHeaders(100, 0, now.Add(-time.Second)))
+
+	if d := l.reserve(); d != 0 {
+		t.Fatalf("expected no delay after reset, got %v", d)
+	}
+
+	if l.Budget().Known {
+		t.Fatal("expected budget to be unknown after reset")
+	}
+}
+
+func TestRateLimiter_ConcurrentReservations(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_000_000, 0)
+	l := newTestLimiter(now)
+	l.Update(rateLimitHeaders(1000, 500, now.Add(time.Minute)))
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_ = l.reserve()
+		}()
+	}
+
+	wg.Wait()
+
+	if got := l.Budget().Remaining; got != 450 {
+		t.Fatalf("expected 450 remaining after 50 reservations, got %d", got)
+	}
+}
+
+func TestClient_TracksRateLimitHeaders(t *testing.T) {
+	t.Parallel()
+
+	reset := time.Now().Add(time.Minute)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		for k, v := range rateLimitHeaders(100, 97, reset) {
+			w.Header()[k] = v
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]bool{"ok": true})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	if err := client.Get(context.Background(), "/tasks/1", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	budget := client.RateLimit()
+	if !budget.Known || budget.Remaining != 97 || budget.Limit != 100 {
+		t.Fatalf("unexpected budget: %+v", budget)
+	}
+}
+
+func TestClient_SharedRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	reset := time.Now().Add(time.Minute)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		for k, v := range rateLimitHeaders(100, 60, reset) {
+			w.Header()[k] = v
+		}
+	}))
+	defer server.Close()
+
+	shared := NewRateLimiter()
+	a := NewClient("test-api-key", WithBaseURL(server.URL), WithRateLimiter(shared))
+	b := NewClient("test-api-key", WithBaseURL(server.URL), WithRateLimiter(shared))
+
+	if err := a.Get(context.Background(), "/tasks/1", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := b.RateLimit().Remaining; got != 60 {
+		t.Fatalf("expected shared budget of 60, got %d", got)
+	}
+}
@@ -0,0 +1,143 @@
+package api
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryBaseDelay = 500 * time.Millisecond
+	defaultRetryMaxWait   = 30 * time.Second
+)
+
+// retryPolicy controls how failed requests are retried.
+// A zero maxRetries disables retries entirely.
+type retryPolicy struct {
+	maxRetries int
+	baseDelay  time.Duration
+	maxWait    time.Duration
+}
+
+// WithRetries sets how many times a request is retried after a 429, a 5xx on
+// an idempotent method, or a transport error on an idempotent method.
+func WithRetries(n int) ClientOption {
+	return func(c *Client) {
+		if n < 0 {
+			n = 0
+		}
+
+		c.retry.maxRetries = n
+	}
+}
+
+// WithRetryMaxWait caps the delay between two attempts, including delays
+// requested by the server through Retry-After or X-RateLimit-Reset.
+func WithRetryMaxWait(d time.Duration) ClientOption {
+	return func(c *Client) {
+		if d > 0 {
+			c.retry.maxWait = d
+		}
+	}
+}
+
+// WithRetryBaseDelay sets the initial backoff delay, doubled on every attempt.
+func WithRetryBaseDelay(d time.Duration) ClientOption {
+	return func(c *Client) {
+		if d > 0 {
+			c.retry.baseDelay = d
+		}
+	}
+}
+
+// isIdempotent reports whether a request with this method can be safely sent twice.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// shouldRetry decides whether an attempt is worth repeating. Rate limiting is
+// retried for every method because ClickUp rejects the request before acting
+// on it; server errors and transport failures only for idempotent methods.
+func shouldRetry(method string, resp *http.Response, err error) bool {
+	if err != nil {
+		return isIdempotent(method)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true
+	case resp.StatusCode == http.StatusNotImplemented:
+		return false
+	case resp.StatusCode >= 500:
+		return isIdempotent(method)
+	default:
+		return false
+	}
+}
+
+// delay returns how long to wait before the next attempt. Server hints win
+// over exponential backoff; both are capped at maxWait.
+func (p retryPolicy) delay(attempt int, resp *http.Response, now time.Time) time.Duration {
+	if resp != nil {
+		if d, ok := serverRetryDelay(resp, now); ok {
+			return min(d, p.maxWait)
+		}
+	}
+
+	backoff := p.baseDelay << attempt
+	if backoff <= 0 || backoff > p.maxWait {
+		backoff = p.maxWait
+	}
+
+	// Jitter over the upper half keeps concurrent callers from retrying in lockstep.
+	half := backoff / 2
+
+	return half + rand.N(half+1) //nolint:gosec // jitter does not need a CSPRNG
+}
+
+// serverRetryDelay extracts the wait requested by the server. Retry-After may
+// be delta-seconds or an HTTP date; X-RateLimit-Reset is a Unix timestamp in
+// seconds and only meaningful on 429 responses.
+func serverRetryDelay(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+			return time.Duration(secs) * time.Second, true
+		}
+
+		if t, err := http.ParseTime(v); err == nil {
+			return max(t.Sub(now), 0), true
+		}
+	}
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	if v := resp.Header.Get("X-RateLimit-Reset"); v != "" {
+		if epoch, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return max(time.Unix(epoch, 0).Sub(now), 0), true
+		}
+	}
+
+	return 0, false
+}
+
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
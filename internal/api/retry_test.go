@@ -0,0 +1,298 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGet_RetriesServerErrors(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"id": "task-1"})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key",
+		WithBaseURL(server.URL),
+		WithRetries(3),
+		WithRetryBaseDelay(time.Millisecond),
+	)
+
+	var result struct {
+		ID string `json:"id"`
+	}
+
+	if err := client.Get(context.Background(), "/tasks/1", &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.ID != "task-1" {
+		t.Fatalf("expected id task-1, got %s", result.ID)
+	}
+
+	if got := calls.Load(); got != 3 {
+		t.Fatalf("expected 3 attempts, got %d", got)
+	}
+}
+
+func TestGet_GivesUpAfterMaxRetries(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key",
+		WithBaseURL(server.URL),
+		WithRetries(2),
+		WithRetryBaseDelay(time.Millisecond),
+	)
+
+	err := client.Get(context.Background(), "/tasks/1", nil)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T", err)
+	}
+
+	if apiErr.StatusCode != http.StatusBadGateway {
+		t.Fatalf("expected status 502, got %d", apiErr.StatusCode)
+	}
+
+	if got := calls.Load(); got != 3 {
+		t.Fatalf("expected 3 attempts, got %d", got)
+	}
+}
+
+func TestPost_DoesNotRetryServerErrors(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key",
+		WithBaseURL(server.URL),
+		WithRetries(3),
+		WithRetryBaseDelay(time.Millisecond),
+	)
+
+	if err := client.Post(context.Background(), "/tasks", map[string]string{"name": "x"}, nil); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("expected 1 attempt for non-idempotent POST, got %d", got)
+	}
+}
+
+func TestPost_RetriesRateLimitAndReplaysBody(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+
+		if !bytes.Contains(body, []byte(`"name":"Task"`)) {
+			t.Fatalf("expected body to be replayed, got %s", body)
+		}
+
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]bool{"ok": true})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL), WithRetries(1))
+
+	if err := client.Post(context.Background(), "/tasks", map[string]string{"name": "Task"}, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected 2 attempts, got %d", got)
+	}
+}
+
+func TestPostMultipart_RetriesRateLimit(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !bytes.Contains(body, []byte("file content")) {
+			t.Fatalf("expected multipart body on every attempt, got %s", body)
+		}
+
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]bool{"ok": true})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL), WithRetries(1))
+
+	if err := client.PostMultipart(context.Background(), "/upload", "attachment", bytes.NewReader([]byte("file content")), "a.txt", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected 2 attempts, got %d", got)
+	}
+}
+
+func TestDeleteWithBody_RetriesServerErrors(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key",
+		WithBaseURL(server.URL),
+		WithRetries(1),
+		WithRetryBaseDelay(time.Millisecond),
+	)
+
+	if err := client.DeleteWithBody(context.Background(), "/tags", map[string]string{"name": "x"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected 2 attempts, got %d", got)
+	}
+}
+
+func TestGet_RetryStopsWhenContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL), WithRetries(5), WithRetryMaxWait(time.Minute))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := client.Get(ctx, "/tasks/1", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestRetryPolicyDelay_HonoursServerHints(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_000_000, 0)
+	policy := retryPolicy{baseDelay: time.Second, maxWait: 30 * time.Second}
+
+	tests := []struct {
+		name   string
+		status int
+		header http.Header
+		want   time.Duration
+	}{
+		{
+			name:   "retry-after seconds",
+			status: http.StatusTooManyRequests,
+			header: http.Header{"Retry-After": {"7"}},
+			want:   7 * time.Second,
+		},
+		{
+			name:   "retry-after http date",
+			status: http.StatusServiceUnavailable,
+			header: http.Header{"Retry-After": {now.Add(4 * time.Second).UTC().Format(http.TimeFormat)}},
+			want:   4 * time.Second,
+		},
+		{
+			name:   "rate limit reset",
+			status: http.StatusTooManyRequests,
+			header: http.Header{"X-Ratelimit-Reset": {strconv.FormatInt(now.Add(12*time.Second).Unix(), 10)}},
+			want:   12 * time.Second,
+		},
+		{
+			name:   "capped at max wait",
+			status: http.StatusTooManyRequests,
+			header: http.Header{"Retry-After": {"600"}},
+			want:   30 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &http.Response{StatusCode: tt.status, Header: tt.header}
+			if got := policy.delay(0, resp, now); got != tt.want {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyDelay_ExponentialBackoffWithJitter(t *testing.T) {
+	t.Parallel()
+
+	policy := retryPolicy{baseDelay: 100 * time.Millisecond, maxWait: time.Second}
+	resp := &http.Response{StatusCode: http.StatusBadGateway, Header: http.Header{}}
+
+	for attempt, ceiling := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		ceiling *= time.Millisecond
+
+		got := policy.delay(attempt, resp, time.Now())
+		if got < ceiling/2 || got > ceiling {
+			t.Fatalf("attempt %d: expected delay in [%v, %v], got %v", attempt, ceiling/2, ceiling, got)
+		}
+	}
+}
@@ -0,0 +1,314 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"mime"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// traceBodyLimit caps how much of each body is written to the debug log, and
+// harBodyLimit how much is written to the HAR file.
+const (
+	traceBodyLimit = 1024
+	harBodyLimit   = 64 << 10
+)
+
+// rateLimitHeaderNames are logged with every traced response.
+var rateLimitHeaderNames = []string{"X-RateLimit-Limit", "X-RateLimit-Remaining", "X-RateLimit-Reset"}
+
+// Tracer is an http.RoundTripper that logs every request at debug level and,
+// when given a path, records them to a HAR 1.2 file. The Authorization header
+// and OAuth secrets in bodies are always masked, and bodies are truncated.
+// The HAR file is rewritten after every request so it
+// survives the process exiting early.
+type Tracer struct {
+	next    http.RoundTripper
+	harPath string
+	creator harCreator
+
+	mu  sync.Mutex
+	har harLog
+}
+
+// NewTracer returns a tracer writing HAR output to harPath ("" logs only).
+// version is recorded as the HAR creator version.
+func NewTracer(harPath, version string) *Tracer {
+	return &Tracer{
+		harPath: harPath,
+		creator: harCreator{Name: "clickup-cli", Version: version},
+	}
+}
+
+// WithTracer routes the client's requests through t.
+func WithTracer(t *Tracer) ClientOption {
+	return func(c *Client) {
+		t.next = c.httpClient.Transport
+		if t.next == nil {
+			t.next = http.DefaultTransport
+		}
+
+		c.httpClient.Transport = t
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Tracer) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := drainBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("trace request body: %w", err)
+	}
+
+	start := time.Now()
+	resp, rtErr := t.next.RoundTrip(req)
+	latency := time.Since(start)
+
+	var respBody []byte
+
+	if rtErr == nil {
+		respBody, err = drainBody(&resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("trace response body: %w", err)
+		}
+	}
+
+	t.log(req, reqBody, resp, respBody, latency, rtErr)
+
+	if t.harPath != "" {
+		t.record(req, reqBody, resp, respBody, start, latency, rtErr)
+	}
+
+	return resp, rtErr
+}
+
+func (t *Tracer) log(req *http.Request, reqBody []byte, resp *http.Response, respBody []byte, latency time.Duration, rtErr error) {
+	attrs := []any{
+		"method", req.Method,
+		"url", req.URL.String(),
+		"latency", latency,
+		"request_headers", redactHeaders(req.Header),
+	}
+
+	if len(reqBody) > 0 {
+		attrs = append(attrs, "request_body", traceBody(req.Header, reqBody, traceBodyLimit))
+	}
+
+	if rtErr != nil {
+		slog.Debug("http request failed", append(attrs, "error", rtErr)...)
+		return
+	}
+
+	attrs = append(attrs, "status", resp.StatusCode)
+
+	for _, name := range rateLimitHeaderNames {
+		if v := resp.Header.Get(name); v != "" {
+			attrs = append(attrs, strings.ToLower(name), v)
+		}
+	}
+
+	if len(respBody) > 0 {
+		attrs = append(attrs, "response_body", traceBody(resp.Header, respBody, traceBodyLimit))
+	}
+
+	slog.Debug("http request", attrs...)
+}
+
+// traceBody renders a body for the log or HAR file with its secrets redacted,
+// truncated to limit bytes.
+func traceBody(h http.Header, body []byte, limit int) string {
+	if isMultipart(h) {
+		return fmt.Sprintf("[multipart body, %d bytes]", len(body))
+	}
+
+	body = redactBody(body)
+
+	if len(body) > limit {
+		return fmt.Sprintf("%s... (%d bytes truncated)", body[:limit], len(body)-limit)
+	}
+
+	return string(body)
+}
+
+func isMultipart(h http.Header) bool {
+	mediaType, _, err := mime.ParseMediaType(h.Get("Content-Type"))
+	return err == nil && strings.HasPrefix(mediaType, "multipart/")
+}
+
+func (t *Tracer) record(req *http.Request, reqBody []byte, resp *http.Response, respBody []byte, start time.Time, latency time.Duration, rtErr error) {
+	ms := float64(latency) / float64(time.Millisecond)
+
+	entry := harEntry{
+		StartedDateTime: start.Format(time.RFC3339Nano),
+		Time:            ms,
+		Request: harRequest{
+			Method:      req.Method,
+			URL:         req.URL.String(),
+			HTTPVersion: "HTTP/1.1",
+			Cookies:     []harNameValue{},
+			Headers:     harHeaders(redactHeaders(req.Header)),
+			QueryString: harQuery(req),
+			HeadersSize: -1,
+			BodySize:    len(reqBody),
+		},
+		Response: harResponse{
+			Cookies:     []harNameValue{},
+			Headers:     []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+		Cache:   struct{}{},
+		Timings: harTimings{Send: 0, Wait: ms, Receive: 0},
+	}
+
+	if len(reqBody) > 0 {
+		entry.Request.PostData = &harPostData{
+			MimeType: req.Header.Get("Content-Type"),
+			Text:     traceBody(req.Header, reqBody, harBodyLimit),
+		}
+	}
+
+	if rtErr != nil {
+		entry.Comment = rtErr.Error()
+	} else {
+		entry.Response.Status = resp.StatusCode
+		entry.Response.StatusText = http.StatusText(resp.StatusCode)
+		entry.Response.HTTPVersion = resp.Proto
+		entry.Response.Headers = harHeaders(redactHeaders(resp.Header))
+		entry.Response.Content = harContent{
+			Size:     len(respBody),
+			MimeType: resp.Header.Get("Content-Type"),
+			Text:     traceBody(resp.Header, respBody, harBodyLimit),
+		}
+		entry.Response.BodySize = len(respBody)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.har.Entries = append(t.har.Entries, entry)
+
+	if err := t.writeHAR(); err != nil {
+		slog.Warn("write trace file", "path", t.harPath, "error", err)
+	}
+}
+
+// writeHAR rewrites the HAR file. Callers must hold t.mu.
+func (t *Tracer) writeHAR() error {
+	t.har.Version = "1.2"
+	t.har.Creator = t.creator
+
+	data, err := json.MarshalIndent(struct {
+		Log harLog `json:"log"`
+	}{t.har}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode HAR: %w", err)
+	}
+
+	if err := os.WriteFile(t.harPath, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("write HAR: %w", err)
+	}
+
+	return nil
+}
+
+func harHeaders(h http.Header) []harNameValue {
+	return harPairs(h)
+}
+
+func harQuery(req *http.Request) []harNameValue {
+	return harPairs(req.URL.Query())
+}
+
+// harPairs flattens a multi-valued map into name/value pairs sorted by name.
+func harPairs(m map[string][]string) []harNameValue {
+	out := []harNameValue{}
+
+	for _, name := range slices.Sorted(maps.Keys(m)) {
+		for _, v := range m[name] {
+			out = append(out, harNameValue{Name: name, Value: v})
+		}
+	}
+
+	return out
+}
+
+// HAR 1.2 types; see http://www.softwareishard.com/blog/har-12-spec/.
+
+type harLog struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Entries []harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+//nolint:tagliatelle // HAR 1.2 field names are camelCase
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	Comment         string      `json:"comment,omitempty"`
+}
+
+//nolint:tagliatelle // HAR 1.2 field names are camelCase
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+//nolint:tagliatelle // HAR 1.2 field names are camelCase
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	Content     harContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+//nolint:tagliatelle // HAR 1.2 field names are camelCase
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+//nolint:tagliatelle // HAR 1.2 field names are camelCase
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+}
+
+type harTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
@@ -0,0 +1,154 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestTracer_WritesHARWithMaskedAuthorization(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Remaining", "99")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"task-1"}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "trace.har")
+	client := NewClient("secret-token", WithBaseURL(server.URL), WithTracer(NewTracer(path, "1.2.3")))
+
+	var result map[string]string
+	if err := client.Post(context.Background(), "/tasks?custom=true", map[string]string{"name": "A"}, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result["id"] != "task-1" {
+		t.Fatalf("expected tracer to pass the response through, got %v", result)
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read HAR: %v", err)
+	}
+
+	if strings.Contains(string(raw), "secret-token") {
+		t.Fatal("expected Authorization to be masked in HAR")
+	}
+
+	var har struct {
+		Log struct {
+			Version string `json:"version"`
+			Creator struct {
+				Version string `json:"version"`
+			} `json:"creator"`
+			Entries []struct {
+				Request struct {
+					Method      string         `json:"method"`
+					URL         string         `json:"url"`
+					QueryString []harNameValue `json:"queryString"`
+					PostData    *harPostData   `json:"postData"`
+				} `json:"request"`
+				Response struct {
+					Status  int            `json:"status"`
+					Headers []harNameValue `json:"headers"`
+					Content harContent     `json:"content"`
+				} `json:"response"`
+			} `json:"entries"`
+		} `json:"log"`
+	}
+
+	if err := json.Unmarshal(raw, &har); err != nil {
+		t.Fatalf("parse HAR: %v", err)
+	}
+
+	if har.Log.Version != "1.2" || har.Log.Creator.Version != "1.2.3" || len(har.Log.Entries) != 1 {
+		t.Fatalf("unexpected HAR log: %+v", har.Log)
+	}
+
+	entry := har.Log.Entries[0]
+	if entry.Request.Method != http.MethodPost || entry.Request.URL != server.URL+"/tasks?custom=true" {
+		t.Fatalf("unexpected request: %+v", entry.Request)
+	}
+
+	if len(entry.Request.QueryString) != 1 || entry.Request.QueryString[0].Name != "custom" {
+		t.Fatalf("unexpected query string: %+v", entry.Request.QueryString)
+	}
+
+	if entry.Request.PostData == nil || entry.Request.PostData.Text != `{"name":"A"}` {
+		t.Fatalf("unexpected post data: %+v", entry.Request.PostData)
+	}
+
+	if entry.Response.Status != http.StatusCreated || entry.Response.Content.Text != `{"id":"task-1"}` {
+		t.Fatalf("unexpected response: %+v", entry.Response)
+	}
+}
+
+func TestTraceBody_Truncates(t *testing.T) {
+	t.Parallel()
+
+	body := strings.Repeat("a", traceBodyLimit+10)
+
+	got := traceBody(http.Header{}, []byte(body), traceBodyLimit)
+	if !strings.HasSuffix(got, "... (10 bytes truncated)") || len(got) > traceBodyLimit+30 {
+		t.Fatalf("unexpected truncated body: %q", got[traceBodyLimit:])
+	}
+
+	h := http.Header{"Content-Type": {"multipart/form-data; boundary=x"}}
+	if got := traceBody(h, []byte("binary"), traceBodyLimit); got != "[multipart body, 6 bytes]" {
+		t.Fatalf("expected multipart placeholder, got %q", got)
+	}
+}
+
+func TestTracer_RedactsAndTruncatesHARBodies(t *testing.T) {
+	t.Parallel()
+
+	large := strings.Repeat("x", harBodyLimit)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/oauth/token" {
+			_, _ = w.Write([]byte(`{"access_token":"live-access-token"}`))
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]string{"data": large})
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "trace.har")
+	client := NewClient("", WithBaseURL(server.URL), WithTracer(NewTracer(path, "dev")))
+	ctx := context.Background()
+
+	req := map[string]string{"client_id": "app", "client_secret": "live-secret", "code": "live-code"}
+	if err := client.Post(ctx, "/oauth/token", req, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := client.Get(ctx, "/large", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read HAR: %v", err)
+	}
+
+	for _, secret := range []string{"live-secret", "live-code", "live-access-token"} {
+		if strings.Contains(string(raw), secret) {
+			t.Fatalf("expected %q to be redacted in HAR", secret)
+		}
+	}
+
+	if !strings.Contains(string(raw), "bytes truncated") || len(raw) > 2*harBodyLimit {
+		t.Fatalf("expected the large body to be truncated, HAR is %d bytes", len(raw))
+	}
+}
@@ -0,0 +1,90 @@
+package clickup
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/builtbyrobben/clickup-cli/internal/api"
+)
+
+// Cache kinds, ordered from the top of the hierarchy down.
+const (
+	CacheKindWorkspace = "workspace"
+	CacheKindSpace     = "space"
+	CacheKindFolder    = "folder"
+	CacheKindList      = "list"
+	CacheKindField     = "field"
+	CacheKindMember    = "member"
+)
+
+// HierarchyCacheRules are the hierarchy and member reads worth caching: they
+// are hit by almost every command that resolves names and change rarely.
+var HierarchyCacheRules = []api.CacheRule{
+	{Kind: CacheKindWorkspace, Pattern: regexp.MustCompile(`^/v2/team$`), TTL: time.Hour},
+	{Kind: CacheKindSpace, Pattern: regexp.MustCompile(`^/v2/(team/[^/]+/space|space/[^/]+)$`), TTL: 15 * time.Minute},
+	{Kind: CacheKindFolder, Pattern: regexp.MustCompile(`^/v2/(space/[^/]+/folder|folder/[^/]+)$`), TTL: 15 * time.Minute},
+	{Kind: CacheKindList, Pattern: regexp.MustCompile(`^/v2/((folder|space)/[^/]+/list|list/[^/]+)$`), TTL: 15 * time.Minute},
+	{Kind: CacheKindField, Pattern: regexp.MustCompile(`^/v2/(team|space|folder|list)/[^/]+/field$`), TTL: 30 * time.Minute},
+	{Kind: CacheKindMember, Pattern: regexp.MustCompile(`^/v2/team/[^/]+$`), TTL: 15 * time.Minute},
+}
+
+var (
+	folderTemplatePath = regexp.MustCompile(`^/v2/space/[^/]+/folder_template/[^/]+$`)
+	listTemplatePath   = regexp.MustCompile(`^/v2/(folder|space)/[^/]+/list_template/[^/]+$`)
+	memberChangePath   = regexp.MustCompile(`^/v2/team/[^/]+/(user|guest)(/|$)`)
+)
+
+// hierarchyCascade lists the kinds made stale by a change to each kind:
+// deleting or moving a container also affects everything below it.
+var hierarchyCascade = map[string][]string{
+	CacheKindWorkspace: {CacheKindWorkspace, CacheKindSpace, CacheKindFolder, CacheKindList, CacheKindField, CacheKindMember},
+	CacheKindSpace:     {CacheKindSpace, CacheKindFolder, CacheKindList, CacheKindField},
+	CacheKindFolder:    {CacheKindFolder, CacheKindList, CacheKindField},
+	CacheKindList:      {CacheKindList, CacheKindField},
+	CacheKindField:     {CacheKindField},
+	CacheKindMember:    {CacheKindMember},
+}
+
+// InvalidateHierarchy maps a mutating request to the cache kinds it makes
+// stale. Mutations are matched against the same paths as the cached reads,
+// e.g. PUT /v2/list/{id} or POST /v2/folder/{id}/list both touch lists.
+func InvalidateHierarchy(_, path string) []string {
+	switch {
+	case folderTemplatePath.MatchString(path):
+		return hierarchyCascade[CacheKindFolder]
+	case listTemplatePath.MatchString(path):
+		return hierarchyCascade[CacheKindList]
+	case memberChangePath.MatchString(path):
+		return hierarchyCascade[CacheKindMember]
+	}
+
+	for _, rule := range HierarchyCacheRules {
+		if rule.Pattern.MatchString(path) {
+			return hierarchyCascade[rule.Kind]
+		}
+	}
+
+	return nil
+}
+
+// NewHierarchyCache returns a cache for hierarchy reads stored in dir. Each
+// token and workspace gets its own file, so switching credentials never
+// serves another account's data.
+func NewHierarchyCache(dir, apiKey, workspaceID string) *api.Cache {
+	return api.NewCache(filepath.Join(dir, CacheFileName(apiKey, workspaceID)), HierarchyCacheRules, InvalidateHierarchy)
+}
+
+// CacheFileName derives the cache file name from a hash of the token and the
+// workspace ID, so the token itself never appears on disk.
+func CacheFileName(apiKey, workspaceID string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+
+	if workspaceID == "" {
+		workspaceID = "default"
+	}
+
+	return hex.EncodeToString(sum[:8]) + "-" + workspaceID + ".json"
+}
@@ -0,0 +1,101 @@
+package clickup
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/builtbyrobben/clickup-cli/internal/api"
+)
+
+func TestInvalidateHierarchy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		method string
+		path   string
+		want   []string
+	}{
+		{http.MethodPut, "/v2/list/l1", []string{CacheKindList, CacheKindField}},
+		{http.MethodPost, "/v2/folder/f1/list", []string{CacheKindList, CacheKindField}},
+		{http.MethodDelete, "/v2/space/s1", []string{CacheKindSpace, CacheKindFolder, CacheKindList, CacheKindField}},
+		{http.MethodPost, "/v2/team/t1/space", []string{CacheKindSpace, CacheKindFolder, CacheKindList, CacheKindField}},
+		{http.MethodPost, "/v2/space/s1/folder_template/tpl", []string{CacheKindFolder, CacheKindList, CacheKindField}},
+		{http.MethodPost, "/v2/folder/f1/list_template/tpl", []string{CacheKindList, CacheKindField}},
+		{http.MethodPost, "/v2/list/l1/task", nil},
+		{http.MethodPut, "/v2/task/t1", nil},
+		{http.MethodPost, "/v2/team/t1/user", []string{CacheKindMember}},
+		{http.MethodDelete, "/v2/team/t1/guest/42", []string{CacheKindMember}},
+	}
+
+	for _, tt := range tests {
+		got := InvalidateHierarchy(tt.method, tt.path)
+		if !slices.Equal(got, tt.want) {
+			t.Fatalf("%s %s: expected %v, got %v", tt.method, tt.path, tt.want, got)
+		}
+	}
+}
+
+func TestCacheFileName_HidesToken(t *testing.T) {
+	t.Parallel()
+
+	name := CacheFileName("pk_secret", "ws-1")
+
+	if strings.Contains(name, "pk_secret") {
+		t.Fatalf("expected token to be hashed, got %s", name)
+	}
+
+	if !strings.HasSuffix(name, "-ws-1.json") {
+		t.Fatalf("expected workspace suffix, got %s", name)
+	}
+
+	if name == CacheFileName("pk_other", "ws-1") {
+		t.Fatal("expected different tokens to use different files")
+	}
+}
+
+func TestHierarchyCache_ListUpdateInvalidatesListReads(t *testing.T) {
+	t.Parallel()
+
+	var gets atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			gets.Add(1)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(List{ID: "list-1", Name: "Backlog"})
+	}))
+	defer server.Close()
+
+	cache := NewHierarchyCache(t.TempDir(), "key", "ws-1")
+	client := &Client{Client: api.NewClient("key", api.WithBaseURL(server.URL), api.WithCache(cache))}
+
+	for range 2 {
+		if _, err := client.Lists().Get(context.Background(), "list-1"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if gets.Load() != 1 {
+		t.Fatalf("expected second read to be cached, got %d GETs", gets.Load())
+	}
+
+	if _, err := client.Lists().Update(context.Background(), "list-1", UpdateListRequest{Name: "Renamed"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := client.Lists().Get(context.Background(), "list-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gets.Load() != 2 {
+		t.Fatalf("expected update to invalidate the cached list, got %d GETs", gets.Load())
+	}
+}
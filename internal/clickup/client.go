@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"iter"
 	"net/url"
 	"os"
+	"slices"
+	"strings"
 
 	"github.com/builtbyrobben/clickup-cli/internal/api"
 )
@@ -37,6 +40,8 @@ const defaultBaseURL = "https://api.clickup.com/api"
 type Client struct {
 	*api.Client
 	workspaceID string
+	teamID      func() (string, error)
+	apiOptions  []api.ClientOption
 }
 
 // ClientOption is a functional option for configuring the Client.
@@ -49,19 +54,29 @@ func WithWorkspaceID(workspaceID string) ClientOption {
 	}
 }
 
+// WithAPIOptions forwards options to the underlying api.Client.
+// They are applied after the ClickUp defaults, so they can override them.
+func WithAPIOptions(opts ...api.ClientOption) ClientOption {
+	return func(c *Client) {
+		c.apiOptions = append(c.apiOptions, opts...)
+	}
+}
+
 // NewClient creates a new ClickUp API client.
 func NewClient(apiKey string, opts ...ClientOption) *Client {
-	c := &Client{
-		Client: api.NewClient(apiKey,
-			api.WithBaseURL(defaultBaseURL),
-			api.WithUserAgent("clickup-cli/1.0"),
-		),
-	}
+	c := &Client{}
 
 	for _, opt := range opts {
 		opt(c)
 	}
 
+	apiOpts := append([]api.ClientOption{
+		api.WithBaseURL(defaultBaseURL),
+		api.WithUserAgent("clickup-cli/1.0"),
+	}, c.apiOptions...)
+
+	c.Client = api.NewClient(apiKey, apiOpts...)
+
 	return c
 }
 
@@ -349,6 +364,19 @@ func (s *TemplatesService) List(ctx context.Context, teamID string, page int) (*
 	return &result, nil
 }
 
+// Iter returns an iterator over every task template for a team. The endpoint
+// does not report the last page, so iteration ends at the first empty page.
+func (s *TemplatesService) Iter(ctx context.Context, teamID string) iter.Seq2[TaskTemplate, error] {
+	return Pages(ctx, func(ctx context.Context, page int) ([]TaskTemplate, bool, error) {
+		result, err := s.List(ctx, teamID, page)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return result.Templates, false, nil
+	})
+}
+
 // --- CustomTaskTypesService ---
 
 // CustomTaskTypesService handles custom task type operations.
@@ -385,8 +413,6 @@ func (s *LegacyTimeService) List(ctx context.Context, taskID string, customTaskI
 		return nil, errIDRequired
 	}
 
-	path := fmt.Sprintf("/v2/task/%s/time", taskID)
-
 	params := url.Values{}
 	if customTaskIDs {
 		params.Set("custom_task_ids", "true")
@@ -396,8 +422,9 @@ func (s *LegacyTimeService) List(ctx context.Context, taskID string, customTaskI
 		params.Set("team_id", teamID)
 	}
 
-	if len(params) > 0 {
-		path += "?" + params.Encode()
+	path, err := s.client.taskPath(taskID, "/time", params)
+	if err != nil {
+		return nil, err
 	}
 
 	var result LegacyTimeResponse
@@ -416,7 +443,11 @@ func (s *LegacyTimeService) Track(ctx context.Context, taskID string, req TrackT
 
 	var result TrackTimeResponse
 
-	path := fmt.Sprintf("/v2/task/%s/time", taskID)
+	path, err := s.client.taskPath(taskID, "/time", nil)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := s.client.Post(ctx, path, req, &result); err != nil {
 		return nil, fmt.Errorf("track legacy time: %w", err)
 	}
@@ -430,7 +461,11 @@ func (s *LegacyTimeService) Edit(ctx context.Context, taskID, intervalID string,
 		return errIDRequired
 	}
 
-	path := fmt.Sprintf("/v2/task/%s/time/%s", taskID, intervalID)
+	path, err := s.client.taskPath(taskID, "/time/"+intervalID, nil)
+	if err != nil {
+		return err
+	}
+
 	if err := s.client.Put(ctx, path, req, nil); err != nil {
 		return fmt.Errorf("edit legacy time: %w", err)
 	}
@@ -444,7 +479,11 @@ func (s *LegacyTimeService) Delete(ctx context.Context, taskID, intervalID strin
 		return errIDRequired
 	}
 
-	path := fmt.Sprintf("/v2/task/%s/time/%s", taskID, intervalID)
+	path, err := s.client.taskPath(taskID, "/time/"+intervalID, nil)
+	if err != nil {
+		return err
+	}
+
 	if err := s.client.Delete(ctx, path); err != nil {
 		return fmt.Errorf("delete legacy time: %w", err)
 	}
@@ -506,22 +545,46 @@ type TasksService struct {
 	client *Client
 }
 
-// List returns tasks for a given list.
+// List returns the first page of tasks for a given list.
 func (s *TasksService) List(ctx context.Context, listID string, status, assignee string) (*TasksListResponse, error) {
-	if listID == "" {
-		return nil, errIDRequired
-	}
+	return s.ListPage(ctx, listID, status, assignee, 0)
+}
 
-	path := fmt.Sprintf("/v2/list/%s/task?include_closed=true", listID)
+// ListPage returns one page (up to 100 tasks) of a list's tasks.
+func (s *TasksService) ListPage(ctx context.Context, listID string, status, assignee string, page int) (*TasksListResponse, error) {
+	var filter string
 
 	if status != "" {
-		path += fmt.Sprintf("&statuses[]=%s", url.QueryEscape(status))
+		filter += fmt.Sprintf("&statuses[]=%s", url.QueryEscape(status))
 	}
 
 	if assignee != "" {
-		path += fmt.Sprintf("&assignees[]=%s", url.QueryEscape(assignee))
+		filter += fmt.Sprintf("&assignees[]=%s", url.QueryEscape(assignee))
 	}
 
+	return s.listPage(ctx, listID, page, filter)
+}
+
+// IterAll returns an iterator over every task in a list, closed tasks and
+// subtasks included, fetching pages on demand.
+func (s *TasksService) IterAll(ctx context.Context, listID string) iter.Seq2[Task, error] {
+	return Pages(ctx, func(ctx context.Context, page int) ([]Task, bool, error) {
+		result, err := s.listPage(ctx, listID, page, "&subtasks=true")
+		if err != nil {
+			return nil, false, err
+		}
+
+		return result.Tasks, result.LastPage, nil
+	})
+}
+
+func (s *TasksService) listPage(ctx context.Context, listID string, page int, filter string) (*TasksListResponse, error) {
+	if listID == "" {
+		return nil, errIDRequired
+	}
+
+	path := fmt.Sprintf("/v2/list/%s/task?include_closed=true&page=%d", listID, page) + filter
+
 	var result TasksListResponse
 	if err := s.client.Get(ctx, path, &result); err != nil {
 		return nil, fmt.Errorf("list tasks: %w", err)
@@ -530,15 +593,40 @@ func (s *TasksService) List(ctx context.Context, listID string, status, assignee
 	return &result, nil
 }
 
+// Iter returns an iterator over every task in a list, fetching pages on demand.
+func (s *TasksService) Iter(ctx context.Context, listID string, status, assignee string) iter.Seq2[Task, error] {
+	return Pages(ctx, func(ctx context.Context, page int) ([]Task, bool, error) {
+		result, err := s.ListPage(ctx, listID, status, assignee, page)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return result.Tasks, result.LastPage, nil
+	})
+}
+
 // Get returns a task by ID.
-func (s *TasksService) Get(ctx context.Context, taskID string) (*Task, error) {
+func (s *TasksService) Get(ctx context.Context, taskID string, params GetTaskParams) (*Task, error) {
 	if taskID == "" {
 		return nil, errIDRequired
 	}
 
 	var result Task
 
-	path := fmt.Sprintf("/v2/task/%s", taskID)
+	query := url.Values{}
+	if params.IncludeSubtasks {
+		query.Set("include_subtasks", "true")
+	}
+
+	if params.IncludeMarkdownDescription {
+		query.Set("include_markdown_description", "true")
+	}
+
+	path, err := s.client.taskPath(taskID, "", query)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := s.client.Get(ctx, path, &result); err != nil {
 		return nil, fmt.Errorf("get task: %w", err)
 	}
@@ -558,7 +646,15 @@ func (s *TasksService) Create(ctx context.Context, listID string, req CreateTask
 
 	var result Task
 
-	path := fmt.Sprintf("/v2/list/%s/task", listID)
+	if err := s.client.sameKindTaskIDs(ctx, &req.Parent, &req.LinksTo); err != nil {
+		return nil, err
+	}
+
+	path, err := s.client.withTaskQuery(fmt.Sprintf("/v2/list/%s/task", listID), nil, nonEmpty(req.Parent, req.LinksTo)...)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := s.client.Post(ctx, path, req, &result); err != nil {
 		return nil, fmt.Errorf("create task: %w", err)
 	}
@@ -574,7 +670,15 @@ func (s *TasksService) Update(ctx context.Context, taskID string, req UpdateTask
 
 	var result Task
 
-	path := fmt.Sprintf("/v2/task/%s", taskID)
+	if err := s.client.sameKindTaskIDs(ctx, &taskID, &req.Parent); err != nil {
+		return nil, err
+	}
+
+	path, err := s.client.taskPath(taskID, "", nil, nonEmpty(req.Parent)...)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := s.client.Put(ctx, path, req, &result); err != nil {
 		return nil, fmt.Errorf("update task: %w", err)
 	}
@@ -588,7 +692,11 @@ func (s *TasksService) Delete(ctx context.Context, taskID string) error {
 		return errIDRequired
 	}
 
-	path := fmt.Sprintf("/v2/task/%s", taskID)
+	path, err := s.client.taskPath(taskID, "", nil)
+	if err != nil {
+		return err
+	}
+
 	if err := s.client.Delete(ctx, path); err != nil {
 		return fmt.Errorf("delete task: %w", err)
 	}
@@ -662,6 +770,14 @@ func (s *TasksService) Search(ctx context.Context, teamID string, params Filtere
 		query.Set("date_updated_lt", fmt.Sprintf("%d", params.DateUpdatedLt))
 	}
 
+	if params.Parent != "" {
+		query.Set("parent", params.Parent)
+	}
+
+	for _, listID := range params.ListIDs {
+		query.Add("list_ids[]", listID)
+	}
+
 	if len(query) > 0 {
 		path += "?" + query.Encode()
 	}
@@ -674,13 +790,33 @@ func (s *TasksService) Search(ctx context.Context, teamID string, params Filtere
 	return &result, nil
 }
 
+// SearchIter returns an iterator over every task matching params, starting at
+// params.Page and fetching subsequent pages on demand.
+func (s *TasksService) SearchIter(ctx context.Context, teamID string, params FilteredTeamTasksParams) iter.Seq2[Task, error] {
+	start := params.Page
+
+	return Pages(ctx, func(ctx context.Context, page int) ([]Task, bool, error) {
+		params.Page = start + page
+
+		result, err := s.Search(ctx, teamID, params)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return result.Tasks, result.LastPage, nil
+	})
+}
+
 // TimeInStatus returns time-in-status data for a single task.
 func (s *TasksService) TimeInStatus(ctx context.Context, taskID string) (*TimeInStatusResponse, error) {
 	if taskID == "" {
 		return nil, errIDRequired
 	}
 
-	path := fmt.Sprintf("/v2/task/%s/time_in_status", taskID)
+	path, err := s.client.taskPath(taskID, "/time_in_status", nil)
+	if err != nil {
+		return nil, err
+	}
 
 	var result TimeInStatusResponse
 	if err := s.client.Get(ctx, path, &result); err != nil {
@@ -696,13 +832,27 @@ func (s *TasksService) BulkTimeInStatus(ctx context.Context, taskIDs []string) (
 		return nil, errTaskIDRequired
 	}
 
+	taskIDs = slices.Clone(taskIDs)
+
+	ids := make([]*string, len(taskIDs))
+	for i := range taskIDs {
+		ids[i] = &taskIDs[i]
+	}
+
+	if err := s.client.sameKindTaskIDs(ctx, ids...); err != nil {
+		return nil, err
+	}
+
 	// Build query string with task_ids
 	query := url.Values{}
 	for _, id := range taskIDs {
 		query.Add("task_ids", id)
 	}
 
-	path := "/v2/task/bulk_time_in_status/task_ids?" + query.Encode()
+	path, err := s.client.withTaskQuery("/v2/task/bulk_time_in_status/task_ids", query, taskIDs...)
+	if err != nil {
+		return nil, err
+	}
 
 	var result BulkTimeInStatusResponse
 	if err := s.client.Get(ctx, path, &result); err != nil {
@@ -722,11 +872,26 @@ func (s *TasksService) Merge(ctx context.Context, targetTaskID string, sourceTas
 		return nil, errSourceTaskIDRequired
 	}
 
+	sourceTaskIDs = slices.Clone(sourceTaskIDs)
+
+	ids := []*string{&targetTaskID}
+	for i := range sourceTaskIDs {
+		ids = append(ids, &sourceTaskIDs[i])
+	}
+
+	if err := s.client.sameKindTaskIDs(ctx, ids...); err != nil {
+		return nil, err
+	}
+
 	req := MergeTasksRequest{MergedTaskIDs: sourceTaskIDs}
 
 	var result MergeTasksResponse
 
-	path := fmt.Sprintf("/v2/task/%s/merge", targetTaskID)
+	path, err := s.client.taskPath(targetTaskID, "/merge", nil, sourceTaskIDs...)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := s.client.Post(ctx, path, req, &result); err != nil {
 		return nil, fmt.Errorf("merge tasks: %w", err)
 	}
@@ -740,6 +905,12 @@ func (s *TasksService) Move(ctx context.Context, taskID, listID string) (*MoveTa
 		return nil, errIDRequired
 	}
 
+	// The v3 API only takes internal task IDs.
+	taskID, err := s.client.internalTaskID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
 	path, err := s.client.v3Path(fmt.Sprintf("/tasks/%s/home_list/%s", taskID, listID))
 	if err != nil {
 		return nil, err
@@ -1139,7 +1310,11 @@ func (s *ListsService) AddTask(ctx context.Context, listID, taskID string) error
 		return errIDRequired
 	}
 
-	path := fmt.Sprintf("/v2/list/%s/task/%s", listID, taskID)
+	path, err := s.client.withTaskQuery(fmt.Sprintf("/v2/list/%s/task/%s", listID, taskID), nil, taskID)
+	if err != nil {
+		return err
+	}
+
 	if err := s.client.Post(ctx, path, nil, nil); err != nil {
 		return fmt.Errorf("add task to list: %w", err)
 	}
@@ -1153,7 +1328,11 @@ func (s *ListsService) RemoveTask(ctx context.Context, listID, taskID string) er
 		return errIDRequired
 	}
 
-	path := fmt.Sprintf("/v2/list/%s/task/%s", listID, taskID)
+	path, err := s.client.withTaskQuery(fmt.Sprintf("/v2/list/%s/task/%s", listID, taskID), nil, taskID)
+	if err != nil {
+		return err
+	}
+
 	if err := s.client.Delete(ctx, path); err != nil {
 		return fmt.Errorf("remove task from list: %w", err)
 	}
@@ -1216,7 +1395,10 @@ func (s *MembersService) TaskMembers(ctx context.Context, taskID string) (*Membe
 		return nil, errIDRequired
 	}
 
-	path := fmt.Sprintf("/v2/task/%s/member", taskID)
+	path, err := s.client.taskPath(taskID, "/member", nil)
+	if err != nil {
+		return nil, err
+	}
 
 	var result MemberUsersResponse
 	if err := s.client.Get(ctx, path, &result); err != nil {
@@ -1233,13 +1415,32 @@ type CommentsService struct {
 	client *Client
 }
 
-// List returns all comments for a task.
+// List returns the newest page of comments for a task.
 func (s *CommentsService) List(ctx context.Context, taskID string) (*CommentsListResponse, error) {
+	return s.ListPage(ctx, taskID, "", "")
+}
+
+// ListPage returns a page of a task's comments, newest first. ClickUp sends
+// 25 comments per page; start (a comment's date) and startID (its ID) ask for
+// the comments before that one, and empty values for the newest page.
+func (s *CommentsService) ListPage(ctx context.Context, taskID, start, startID string) (*CommentsListResponse, error) {
 	if taskID == "" {
 		return nil, errIDRequired
 	}
 
-	path := fmt.Sprintf("/v2/task/%s/comment", taskID)
+	query := url.Values{}
+	if start != "" {
+		query.Set("start", start)
+	}
+
+	if startID != "" {
+		query.Set("start_id", startID)
+	}
+
+	path, err := s.client.taskPath(taskID, "/comment", query)
+	if err != nil {
+		return nil, err
+	}
 
 	var result CommentsListResponse
 	if err := s.client.Get(ctx, path, &result); err != nil {
@@ -1249,6 +1450,39 @@ func (s *CommentsService) List(ctx context.Context, taskID string) (*CommentsLis
 	return &result, nil
 }
 
+// IterAll walks every comment of a task, newest first, paging back from the
+// oldest comment of each page until an empty page comes back.
+func (s *CommentsService) IterAll(ctx context.Context, taskID string) iter.Seq2[Comment, error] {
+	seen := map[string]bool{}
+
+	return Cursor(ctx, func(ctx context.Context, cursor string) ([]Comment, string, error) {
+		start, startID, _ := strings.Cut(cursor, "/")
+
+		result, err := s.ListPage(ctx, taskID, start, startID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		// A page may repeat the comment it starts from.
+		comments := make([]Comment, 0, len(result.Comments))
+
+		for _, c := range result.Comments {
+			if !seen[c.ID.String()] {
+				seen[c.ID.String()] = true
+				comments = append(comments, c)
+			}
+		}
+
+		if len(comments) == 0 {
+			return nil, "", nil
+		}
+
+		oldest := comments[len(comments)-1]
+
+		return comments, oldest.Date + "/" + oldest.ID.String(), nil
+	})
+}
+
 // Add creates a new comment on a task.
 func (s *CommentsService) Add(ctx context.Context, taskID string, text string) (*Comment, error) {
 	if taskID == "" {
@@ -1266,7 +1500,11 @@ func (s *CommentsService) Add(ctx context.Context, taskID string, text string) (
 		ID json.Number `json:"id"`
 	}
 
-	path := fmt.Sprintf("/v2/task/%s/comment", taskID)
+	path, err := s.client.taskPath(taskID, "/comment", nil)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := s.client.Post(ctx, path, req, &result); err != nil {
 		return nil, fmt.Errorf("add comment: %w", err)
 	}
@@ -1463,7 +1701,10 @@ func (s *TimeService) List(ctx context.Context, teamID, taskID string) (*TimeEnt
 		return nil, errIDRequired
 	}
 
-	path := fmt.Sprintf("/v2/team/%s/time_entries?task_id=%s", teamID, url.QueryEscape(taskID))
+	path, err := s.client.withTaskQuery(fmt.Sprintf("/v2/team/%s/time_entries", teamID), url.Values{"task_id": {taskID}}, taskID)
+	if err != nil {
+		return nil, err
+	}
 
 	var result TimeEntriesListResponse
 	if err := s.client.Get(ctx, path, &result); err != nil {
@@ -1489,7 +1730,11 @@ func (s *TimeService) Log(ctx context.Context, teamID, taskID string, durationMs
 		Data TimeEntry `json:"data"`
 	}
 
-	path := fmt.Sprintf("/v2/team/%s/time_entries", teamID)
+	path, err := s.client.withTaskQuery(fmt.Sprintf("/v2/team/%s/time_entries", teamID), nil, taskID)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := s.client.Post(ctx, path, req, &result); err != nil {
 		return nil, fmt.Errorf("log time: %w", err)
 	}
@@ -1537,7 +1782,11 @@ func (s *TimeService) Start(ctx context.Context, teamID string, req StartTimeEnt
 
 	var result TimeEntryDetailResponse
 
-	path := fmt.Sprintf("/v2/team/%s/time_entries/start", teamID)
+	path, err := s.client.withTaskQuery(fmt.Sprintf("/v2/team/%s/time_entries/start", teamID), nil, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := s.client.Post(ctx, path, req, &result); err != nil {
 		return nil, fmt.Errorf("start timer: %w", err)
 	}
@@ -1852,7 +2101,11 @@ func (s *GuestsService) AddToTask(ctx context.Context, taskID string, guestID in
 
 	var result GuestResponse
 
-	path := fmt.Sprintf("/v2/task/%s/guest/%d", taskID, guestID)
+	path, err := s.client.taskPath(taskID, fmt.Sprintf("/guest/%d", guestID), nil)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := s.client.Post(ctx, path, req, &result); err != nil {
 		return nil, fmt.Errorf("add guest to task: %w", err)
 	}
@@ -1866,7 +2119,11 @@ func (s *GuestsService) RemoveFromTask(ctx context.Context, taskID string, guest
 		return errIDRequired
 	}
 
-	path := fmt.Sprintf("/v2/task/%s/guest/%d", taskID, guestID)
+	path, err := s.client.taskPath(taskID, fmt.Sprintf("/guest/%d", guestID), nil)
+	if err != nil {
+		return err
+	}
+
 	if err := s.client.Delete(ctx, path); err != nil {
 		return fmt.Errorf("remove guest from task: %w", err)
 	}
@@ -2013,7 +2270,11 @@ func (s *TagsService) AddToTask(ctx context.Context, taskID, tagName string) err
 		return errIDRequired
 	}
 
-	path := fmt.Sprintf("/v2/task/%s/tag/%s", taskID, url.QueryEscape(tagName))
+	path, err := s.client.taskPath(taskID, "/tag/"+url.QueryEscape(tagName), nil)
+	if err != nil {
+		return err
+	}
+
 	if err := s.client.Post(ctx, path, nil, nil); err != nil {
 		return fmt.Errorf("add tag to task: %w", err)
 	}
@@ -2027,7 +2288,11 @@ func (s *TagsService) RemoveFromTask(ctx context.Context, taskID, tagName string
 		return errIDRequired
 	}
 
-	path := fmt.Sprintf("/v2/task/%s/tag/%s", taskID, url.QueryEscape(tagName))
+	path, err := s.client.taskPath(taskID, "/tag/"+url.QueryEscape(tagName), nil)
+	if err != nil {
+		return err
+	}
+
 	if err := s.client.Delete(ctx, path); err != nil {
 		return fmt.Errorf("remove tag from task: %w", err)
 	}
@@ -2054,7 +2319,11 @@ func (s *ChecklistsService) Create(ctx context.Context, taskID string, req Creat
 
 	var result ChecklistResponse
 
-	path := fmt.Sprintf("/v2/task/%s/checklist", taskID)
+	path, err := s.client.taskPath(taskID, "/checklist", nil)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := s.client.Post(ctx, path, req, &result); err != nil {
 		return nil, fmt.Errorf("create checklist: %w", err)
 	}
@@ -2159,7 +2428,15 @@ func (s *RelationshipsService) AddDependency(ctx context.Context, taskID string,
 		return errIDRequired
 	}
 
-	path := fmt.Sprintf("/v2/task/%s/dependency", taskID)
+	if err := s.client.sameKindTaskIDs(ctx, &taskID, &req.DependsOn, &req.DependencyOf); err != nil {
+		return err
+	}
+
+	path, err := s.client.taskPath(taskID, "/dependency", nil, nonEmpty(req.DependsOn, req.DependencyOf)...)
+	if err != nil {
+		return err
+	}
+
 	if err := s.client.Post(ctx, path, req, nil); err != nil {
 		return fmt.Errorf("add dependency: %w", err)
 	}
@@ -2177,18 +2454,22 @@ func (s *RelationshipsService) DeleteDependency(ctx context.Context, taskID stri
 		return errIDRequired
 	}
 
-	// Build query params
-	path := fmt.Sprintf("/v2/task/%s/dependency?", taskID)
+	if err := s.client.sameKindTaskIDs(ctx, &taskID, &req.DependsOn, &req.DependencyOf); err != nil {
+		return err
+	}
+
+	query := url.Values{}
 	if req.DependsOn != "" {
-		path += fmt.Sprintf("depends_on=%s", url.QueryEscape(req.DependsOn))
+		query.Set("depends_on", req.DependsOn)
 	}
 
 	if req.DependencyOf != "" {
-		if req.DependsOn != "" {
-			path += "&"
-		}
+		query.Set("dependency_of", req.DependencyOf)
+	}
 
-		path += fmt.Sprintf("dependency_of=%s", url.QueryEscape(req.DependencyOf))
+	path, err := s.client.taskPath(taskID, "/dependency", query, nonEmpty(req.DependsOn, req.DependencyOf)...)
+	if err != nil {
+		return err
 	}
 
 	if err := s.client.Delete(ctx, path); err != nil {
@@ -2204,7 +2485,15 @@ func (s *RelationshipsService) AddLink(ctx context.Context, taskID, linkedTaskID
 		return errIDRequired
 	}
 
-	path := fmt.Sprintf("/v2/task/%s/link/%s", taskID, linkedTaskID)
+	if err := s.client.sameKindTaskIDs(ctx, &taskID, &linkedTaskID); err != nil {
+		return err
+	}
+
+	path, err := s.client.taskPath(taskID, "/link/"+linkedTaskID, nil, linkedTaskID)
+	if err != nil {
+		return err
+	}
+
 	if err := s.client.Post(ctx, path, nil, nil); err != nil {
 		return fmt.Errorf("add task link: %w", err)
 	}
@@ -2218,7 +2507,15 @@ func (s *RelationshipsService) DeleteLink(ctx context.Context, taskID, linkedTas
 		return errIDRequired
 	}
 
-	path := fmt.Sprintf("/v2/task/%s/link/%s", taskID, linkedTaskID)
+	if err := s.client.sameKindTaskIDs(ctx, &taskID, &linkedTaskID); err != nil {
+		return err
+	}
+
+	path, err := s.client.taskPath(taskID, "/link/"+linkedTaskID, nil, linkedTaskID)
+	if err != nil {
+		return err
+	}
+
 	if err := s.client.Delete(ctx, path); err != nil {
 		return fmt.Errorf("delete task link: %w", err)
 	}
@@ -2305,7 +2602,11 @@ func (s *CustomFieldsService) Set(ctx context.Context, taskID, fieldID string, v
 
 	req := SetCustomFieldRequest{Value: value}
 
-	path := fmt.Sprintf("/v2/task/%s/field/%s", taskID, fieldID)
+	path, err := s.client.taskPath(taskID, "/field/"+fieldID, nil)
+	if err != nil {
+		return err
+	}
+
 	if err := s.client.Post(ctx, path, req, nil); err != nil {
 		return fmt.Errorf("set custom field: %w", err)
 	}
@@ -2319,7 +2620,11 @@ func (s *CustomFieldsService) Remove(ctx context.Context, taskID, fieldID string
 		return errIDRequired
 	}
 
-	path := fmt.Sprintf("/v2/task/%s/field/%s", taskID, fieldID)
+	path, err := s.client.taskPath(taskID, "/field/"+fieldID, nil)
+	if err != nil {
+		return err
+	}
+
 	if err := s.client.Delete(ctx, path); err != nil {
 		return fmt.Errorf("remove custom field: %w", err)
 	}
@@ -2434,6 +2739,19 @@ func (s *ViewsService) Tasks(ctx context.Context, viewID string, page int) (*Vie
 	return &result, nil
 }
 
+// TasksIter returns an iterator over every task in a view, starting at page
+// start and fetching subsequent pages on demand.
+func (s *ViewsService) TasksIter(ctx context.Context, viewID string, start int) iter.Seq2[Task, error] {
+	return Pages(ctx, func(ctx context.Context, page int) ([]Task, bool, error) {
+		result, err := s.Tasks(ctx, viewID, start+page)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return result.Tasks, result.LastPage, nil
+	})
+}
+
 // CreateInTeam creates a view in a workspace/team.
 func (s *ViewsService) CreateInTeam(ctx context.Context, teamID string, req CreateViewRequest) (*View, error) {
 	if teamID == "" {
@@ -2581,6 +2899,14 @@ func (s *WebhooksService) Create(ctx context.Context, teamID string, req CreateW
 		return nil, errEventsRequired
 	}
 
+	// Webhooks are registered against internal task IDs.
+	taskID, err := s.client.internalTaskID(ctx, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	req.TaskID = taskID
+
 	var result Webhook
 
 	path := fmt.Sprintf("/v2/team/%s/webhook", teamID)
@@ -2864,7 +3190,10 @@ func (s *AttachmentsService) Upload(ctx context.Context, taskID, filePath string
 	}
 	defer file.Close()
 
-	path := fmt.Sprintf("/v2/task/%s/attachment", taskID)
+	path, err := s.client.taskPath(taskID, "/attachment", nil)
+	if err != nil {
+		return nil, err
+	}
 
 	var result Attachment
 	if err := s.client.PostMultipart(ctx, path, "attachment", file, filePath, &result); err != nil {
@@ -3145,6 +3474,24 @@ func (s *ChatService) ListMessages(ctx context.Context, channelID string, limit
 	return &result, nil
 }
 
+// MessagesIter returns an iterator over every message in a channel, following
+// next_page_token. pageSize is passed through as the per-request limit.
+func (s *ChatService) MessagesIter(ctx context.Context, channelID string, pageSize int) iter.Seq2[ChatMessage, error] {
+	return Cursor(ctx, func(ctx context.Context, cursor string) ([]ChatMessage, string, error) {
+		result, err := s.ListMessages(ctx, channelID, pageSize, cursor)
+		if err != nil {
+			return nil, "", err
+		}
+
+		next := ""
+		if result.Pagination != nil {
+			next = result.Pagination.NextPageToken
+		}
+
+		return result.Data, next, nil
+	})
+}
+
 // SendMessage sends a message to a channel.
 func (s *ChatService) SendMessage(ctx context.Context, channelID string, req SendMessageRequest) (*ChatMessage, error) {
 	if channelID == "" {
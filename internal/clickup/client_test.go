@@ -97,6 +97,50 @@ func TestTasksList_EncodesFilters(t *testing.T) {
 	}
 }
 
+func TestTasksGet_IncludesSubtasksAndMarkdown(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/task/task-1" {
+			t.Fatalf("expected path /v2/task/task-1, got %s", r.URL.Path)
+		}
+
+		if r.URL.Query().Get("include_subtasks") != "true" {
+			t.Fatalf("expected include_subtasks=true, got %s", r.URL.Query().Get("include_subtasks"))
+		}
+
+		if r.URL.Query().Get("include_markdown_description") != "true" {
+			t.Fatalf("expected include_markdown_description=true, got %s", r.URL.Query().Get("include_markdown_description"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"task-1","name":"Parent","time_spent":60000,
+			"subtasks":[{"id":"task-2","name":"Child","status":{"status":"open"}}],
+			"checklists":[{"id":"c1","name":"QA","items":[{"id":"i1","name":"Test","resolved":true}]}],
+			"dependencies":[{"task_id":"task-1","depends_on":"task-0","type":1}]}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+
+	task, err := client.Tasks().Get(context.Background(), "task-1", GetTaskParams{IncludeSubtasks: true, IncludeMarkdownDescription: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(task.Subtasks) != 1 || task.Subtasks[0].ID != "task-2" {
+		t.Fatalf("expected subtask task-2, got %#v", task.Subtasks)
+	}
+
+	if len(task.Checklists) != 1 || !task.Checklists[0].Items[0].Resolved {
+		t.Fatalf("expected resolved checklist item, got %#v", task.Checklists)
+	}
+
+	if len(task.Dependencies) != 1 || task.Dependencies[0].DependsOn != "task-0" || task.TimeSpent != 60000 {
+		t.Fatalf("unexpected task %#v", task)
+	}
+}
+
 func TestMembersList_ExtractsNestedMembers(t *testing.T) {
 	t.Parallel()
 
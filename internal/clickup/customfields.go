@@ -0,0 +1,221 @@
+package clickup
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// CustomFieldValues turns NAME=VALUE assignments into custom field values.
+// NAME is a field ID or, case-insensitively, a field name from fields.
+// VALUE is kept as a string for text-like fields (see stringFieldTypes);
+// for other fields it is decoded as JSON when it parses (numbers, booleans,
+// arrays, objects) and is a string otherwise. Drop-down and label option
+// names are replaced by their option IDs.
+func CustomFieldValues(fields []CustomField, assignments []string) ([]CustomFieldValue, error) {
+	values := make([]CustomFieldValue, 0, len(assignments))
+
+	for _, assignment := range assignments {
+		name, raw, ok := strings.Cut(assignment, "=")
+		if !ok {
+			return nil, fmt.Errorf("custom field %q: expected NAME=VALUE", assignment)
+		}
+
+		field, err := FindCustomField(fields, strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := customFieldValue(field, raw)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, CustomFieldValue{ID: field.ID, Value: value})
+	}
+
+	return values, nil
+}
+
+// FindCustomField returns the field with this ID or, case-insensitively,
+// this name. A name shared by several fields is an error.
+func FindCustomField(fields []CustomField, name string) (CustomField, error) {
+	var matches []CustomField
+
+	for _, f := range fields {
+		if f.ID == name {
+			return f, nil
+		}
+
+		if strings.EqualFold(f.Name, name) {
+			matches = append(matches, f)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return CustomField{}, fmt.Errorf("no custom field %q on this list", name)
+	case 1:
+		return matches[0], nil
+	}
+
+	ids := make([]string, 0, len(matches))
+	for _, m := range matches {
+		ids = append(ids, m.ID)
+	}
+
+	return CustomField{}, fmt.Errorf("custom field name %q is ambiguous; use one of the IDs %s", name, strings.Join(ids, ", "))
+}
+
+// stringFieldTypes are custom field types whose values are always strings,
+// so that a phone number or a text of "true" is not sent as JSON.
+var stringFieldTypes = map[string]bool{
+	"text":       true,
+	"short_text": true,
+	"phone":      true,
+	"email":      true,
+	"url":        true,
+}
+
+func customFieldValue(field CustomField, raw string) (any, error) {
+	if stringFieldTypes[field.Type] {
+		return raw, nil
+	}
+
+	var value any
+	if err := json.Unmarshal([]byte(raw), &value); err != nil {
+		value = raw
+	}
+
+	switch field.Type {
+	case "drop_down":
+		if name, ok := value.(string); ok {
+			return optionID(field, name)
+		}
+	case "labels":
+		names := strings.Split(raw, ",")
+		if list, ok := value.([]any); ok {
+			names = names[:0]
+
+			for _, v := range list {
+				names = append(names, fmt.Sprint(v))
+			}
+		}
+
+		ids := make([]any, 0, len(names))
+
+		for _, name := range names {
+			id, err := optionID(field, strings.TrimSpace(name))
+			if err != nil {
+				return nil, err
+			}
+
+			ids = append(ids, id)
+		}
+
+		return ids, nil
+	}
+
+	return value, nil
+}
+
+// optionID maps an option name (or label) of a drop-down or labels field to
+// its ID. Values that already are option IDs are returned as is.
+func optionID(field CustomField, name string) (string, error) {
+	for _, option := range fieldOptions(field.TypeConfig) {
+		id, _ := option["id"].(string)
+
+		if id == name {
+			return id, nil
+		}
+
+		for _, key := range []string{"name", "label"} {
+			if label, ok := option[key].(string); ok && strings.EqualFold(label, name) {
+				return id, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("custom field %q has no option %q", field.Name, name)
+}
+
+// Text renders the field's value for display: drop-down and label options
+// by name, people by username and tasks by name. Date values are left as
+// millisecond timestamps for the caller to format. Unset fields are "".
+func (f TaskCustomField) Text() string {
+	if f.Value == nil {
+		return ""
+	}
+
+	options := fieldOptions(f.TypeConfig)
+
+	switch f.Type {
+	case "drop_down":
+		for _, option := range options {
+			if fmt.Sprint(option["orderindex"]) == fmt.Sprint(f.Value) || option["id"] == f.Value {
+				return fmt.Sprint(option["name"])
+			}
+		}
+	case "labels", "users", "tasks":
+		items, _ := f.Value.([]any)
+		names := make([]string, 0, len(items))
+
+		for _, item := range items {
+			names = append(names, itemName(item, options))
+		}
+
+		return strings.Join(names, ", ")
+	case "location":
+		if location, ok := f.Value.(map[string]any); ok {
+			if address, ok := location["formatted_address"].(string); ok {
+				return address
+			}
+		}
+	}
+
+	switch v := f.Value.(type) {
+	case string:
+		return v
+	case float64, bool:
+		return fmt.Sprint(v)
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
+
+// itemName names one element of a labels, users or tasks value.
+func itemName(item any, options []map[string]any) string {
+	if id, ok := item.(string); ok {
+		for _, option := range options {
+			if option["id"] == id {
+				return fmt.Sprint(option["label"])
+			}
+		}
+
+		return id
+	}
+
+	object, _ := item.(map[string]any)
+	for _, key := range []string{"username", "name", "email", "id"} {
+		if v, ok := object[key]; ok && v != nil && v != "" {
+			return fmt.Sprint(v)
+		}
+	}
+
+	return fmt.Sprint(item)
+}
+
+func fieldOptions(typeConfig any) []map[string]any {
+	config, _ := typeConfig.(map[string]any)
+	raw, _ := config["options"].([]any)
+
+	options := make([]map[string]any, 0, len(raw))
+	for _, o := range raw {
+		if option, ok := o.(map[string]any); ok {
+			options = append(options, option)
+		}
+	}
+
+	return options
+}
@@ -0,0 +1,134 @@
+package clickup
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestCustomFieldValues(t *testing.T) {
+	t.Parallel()
+
+	var fields []CustomField
+	if err := json.Unmarshal([]byte(`[
+		{"id":"f-points","name":"Story Points","type":"number"},
+		{"id":"f-team","name":"Team","type":"drop_down","type_config":{"options":[{"id":"o-be","name":"Backend"},{"id":"o-fe","name":"Frontend"}]}},
+		{"id":"f-tags","name":"Areas","type":"labels","type_config":{"options":[{"id":"l-api","label":"API"},{"id":"l-ui","label":"UI"}]}},
+		{"id":"f-notes","name":"Notes","type":"text"},
+		{"id":"f-code","name":"Code","type":"short_text"},
+		{"id":"f-phone","name":"Phone","type":"phone"},
+		{"id":"f-email","name":"Email","type":"email"},
+		{"id":"f-site","name":"Site","type":"url"}
+	]`), &fields); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := CustomFieldValues(fields, []string{
+		"story points=5",
+		"Team=frontend",
+		"Areas=API,UI",
+		"f-notes=ship it",
+		"Notes=true",
+		"Code=007",
+		"Phone=+1 555 0100",
+		"Phone=5550100",
+		"Email=null",
+		`Site="https://example.com"`,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []CustomFieldValue{
+		{ID: "f-points", Value: float64(5)},
+		{ID: "f-team", Value: "o-fe"},
+		{ID: "f-tags", Value: []any{"l-api", "l-ui"}},
+		{ID: "f-notes", Value: "ship it"},
+		{ID: "f-notes", Value: "true"},
+		{ID: "f-code", Value: "007"},
+		{ID: "f-phone", Value: "+1 555 0100"},
+		{ID: "f-phone", Value: "5550100"},
+		{ID: "f-email", Value: "null"},
+		{ID: "f-site", Value: `"https://example.com"`},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %#v, got %#v", want, got)
+	}
+
+	for _, bad := range []string{"Missing=1", "Team=Ops", "no-equals"} {
+		if _, err := CustomFieldValues(fields, []string{bad}); err == nil {
+			t.Fatalf("%q: expected error", bad)
+		}
+	}
+}
+
+func TestUpdateTaskRequest_ClearsDueDate(t *testing.T) {
+	t.Parallel()
+
+	body, err := json.Marshal(UpdateTaskRequest{Name: "x", DueDate: ClearableMillis{Clear: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(body) != `{"name":"x","due_date":null}` {
+		t.Fatalf("unexpected body %s", body)
+	}
+
+	body, err = json.Marshal(UpdateTaskRequest{StartDate: ClearableMillis{Millis: 1700000000000}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(body) != `{"start_date":1700000000000}` {
+		t.Fatalf("unexpected body %s", body)
+	}
+}
+
+func TestUpdateTaskRequest_ClearsPriority(t *testing.T) {
+	t.Parallel()
+
+	body, err := json.Marshal(UpdateTaskRequest{Name: "x", ClearPriority: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(body) != `{"name":"x","priority":null}` {
+		t.Fatalf("unexpected body %s", body)
+	}
+
+	high := 2
+
+	body, err = json.Marshal(UpdateTaskRequest{Priority: &high})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(body) != `{"priority":2}` {
+		t.Fatalf("unexpected body %s", body)
+	}
+}
+
+func TestTaskCustomField_Text(t *testing.T) {
+	t.Parallel()
+
+	var fields []TaskCustomField
+	if err := json.Unmarshal([]byte(`[
+		{"name":"Team","type":"drop_down","type_config":{"options":[{"id":"o-be","name":"Backend","orderindex":0},{"id":"o-fe","name":"Frontend","orderindex":1}]},"value":1},
+		{"name":"Areas","type":"labels","type_config":{"options":[{"id":"l-api","label":"API"},{"id":"l-ui","label":"UI"}]},"value":["l-ui","l-api"]},
+		{"name":"Reviewers","type":"users","value":[{"id":1,"username":"ada"},{"id":2,"username":"grace"}]},
+		{"name":"Points","type":"number","value":"3"},
+		{"name":"Done","type":"checkbox","value":true},
+		{"name":"Empty","type":"text"}
+	]`), &fields); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"Frontend", "UI, API", "ada, grace", "3", "true", ""}
+
+	for i, f := range fields {
+		if got := f.Text(); got != want[i] {
+			t.Fatalf("%s: expected %q, got %q", f.Name, want[i], got)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package clickup
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+)
+
+// customTaskID matches custom task IDs such as ENG-1234: an upper-case
+// prefix, a dash and a number. Internal task IDs are lower-case
+// alphanumerics, so they never match.
+var customTaskID = regexp.MustCompile(`^[A-Z][A-Z0-9]*-\d+$`)
+
+// IsCustomTaskID reports whether id is a custom task ID rather than an
+// internal one.
+func IsCustomTaskID(id string) bool {
+	return customTaskID.MatchString(id)
+}
+
+// WithTeamID sets where the workspace (team) ID comes from. ClickUp needs it
+// alongside custom task IDs; teamID is only called when one is used.
+func WithTeamID(teamID func() (string, error)) ClientOption {
+	return func(c *Client) {
+		c.teamID = teamID
+	}
+}
+
+// customTaskQuery adds custom_task_ids=true and team_id to query when ids are
+// custom task IDs. The flag covers every task ID in a request, so callers
+// convert mixed IDs with sameKindTaskIDs first.
+func (c *Client) customTaskQuery(query url.Values, ids ...string) error {
+	// The caller has already chosen, as with --custom-task-ids.
+	if query.Has("custom_task_ids") {
+		return nil
+	}
+
+	custom := 0
+
+	for _, id := range ids {
+		if IsCustomTaskID(id) {
+			custom++
+		}
+	}
+
+	if custom == 0 {
+		return nil
+	}
+
+	if c.teamID == nil {
+		return fmt.Errorf("custom task ID %s: no team ID configured", ids[0])
+	}
+
+	teamID, err := c.teamID()
+	if err != nil {
+		return fmt.Errorf("custom task ID %s: %w", ids[0], err)
+	}
+
+	query.Set("custom_task_ids", "true")
+	query.Set("team_id", teamID)
+
+	return nil
+}
+
+// taskPath builds the path of a v2 task endpoint, /v2/task/{taskID}{suffix},
+// with query and, for a custom task ID, the parameters that select it. Other
+// task IDs in the request, given in others, must be of the same kind.
+func (c *Client) taskPath(taskID, suffix string, query url.Values, others ...string) (string, error) {
+	return c.withTaskQuery(fmt.Sprintf("/v2/task/%s%s", taskID, suffix), query, append([]string{taskID}, others...)...)
+}
+
+// withTaskQuery appends query to path after adding the custom task ID
+// parameters that ids need.
+func (c *Client) withTaskQuery(path string, query url.Values, ids ...string) (string, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+
+	if err := c.customTaskQuery(query, ids...); err != nil {
+		return "", err
+	}
+
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	return path, nil
+}
+
+// nonEmpty drops empty IDs, for optional task references in a request.
+func nonEmpty(ids ...string) []string {
+	var out []string
+
+	for _, id := range ids {
+		if id != "" {
+			out = append(out, id)
+		}
+	}
+
+	return out
+}
+
+// internalTaskID returns the internal ID of a task given by custom ID, for
+// endpoints that only take internal IDs. Other IDs are returned unchanged.
+func (c *Client) internalTaskID(ctx context.Context, id string) (string, error) {
+	if !IsCustomTaskID(id) {
+		return id, nil
+	}
+
+	task, err := c.Tasks().Get(ctx, id, GetTaskParams{})
+	if err != nil {
+		return "", err
+	}
+
+	return task.ID, nil
+}
+
+// sameKindTaskIDs converts the custom task IDs among ids to internal ones
+// when the IDs mix both kinds, so that one request can carry them all. Empty
+// IDs are skipped.
+func (c *Client) sameKindTaskIDs(ctx context.Context, ids ...*string) error {
+	custom, internal := 0, 0
+
+	for _, id := range ids {
+		switch {
+		case *id == "":
+		case IsCustomTaskID(*id):
+			custom++
+		default:
+			internal++
+		}
+	}
+
+	if custom == 0 || internal == 0 {
+		return nil
+	}
+
+	for _, id := range ids {
+		converted, err := c.internalTaskID(ctx, *id)
+		if err != nil {
+			return err
+		}
+
+		*id = converted
+	}
+
+	return nil
+}
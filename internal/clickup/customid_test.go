@@ -0,0 +1,105 @@
+package clickup
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestIsCustomTaskID(t *testing.T) {
+	t.Parallel()
+
+	for id, want := range map[string]bool{
+		"ENG-1234": true,
+		"AB2-7":    true,
+		"86abc123": false,
+		"task-1":   false,
+		"ENG-":     false,
+		"ENG1234":  false,
+	} {
+		if got := IsCustomTaskID(id); got != want {
+			t.Errorf("IsCustomTaskID(%q) = %v, want %v", id, got, want)
+		}
+	}
+}
+
+func TestCustomTaskIDs_SendTeamAndFlag(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		switch r.URL.Path {
+		case "/v2/task/ENG-12", "/v2/task/ENG-12/comment":
+			if q.Get("custom_task_ids") != "true" || q.Get("team_id") != "team-1" {
+				t.Fatalf("expected custom_task_ids and team_id, got %s", r.URL.RawQuery)
+			}
+		case "/v2/task/86abc":
+			if q.Has("custom_task_ids") || q.Has("team_id") {
+				t.Fatalf("expected no custom task ID parameters, got %s", r.URL.RawQuery)
+			}
+		default:
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"86abc","comments":[]}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.teamID = func() (string, error) { return "team-1", nil }
+
+	ctx := context.Background()
+
+	if _, err := client.Tasks().Get(ctx, "ENG-12", GetTaskParams{IncludeSubtasks: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := client.Comments().List(ctx, "ENG-12"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := client.Tasks().Get(ctx, "86abc", GetTaskParams{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCustomTaskIDs_MixedIDsAreConverted(t *testing.T) {
+	t.Parallel()
+
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"86eng12"}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.teamID = func() (string, error) { return "team-1", nil }
+
+	ctx := context.Background()
+
+	if err := client.Relationships().AddLink(ctx, "ENG-12", "86abc"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := client.Tasks().Update(ctx, "86abc", UpdateTaskRequest{Parent: "ENG-12"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{
+		"GET /v2/task/ENG-12?custom_task_ids=true&team_id=team-1",
+		"POST /v2/task/86eng12/link/86abc",
+		"GET /v2/task/ENG-12?custom_task_ids=true&team_id=team-1",
+		"PUT /v2/task/86abc",
+	}
+	if !slices.Equal(requests, want) {
+		t.Fatalf("expected %v, got %v", want, requests)
+	}
+}
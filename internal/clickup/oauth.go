@@ -0,0 +1,167 @@
+package clickup
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// DefaultAuthorizeURL is ClickUp's OAuth consent page.
+const DefaultAuthorizeURL = "https://app.clickup.com/api"
+
+var (
+	errClientIDRequired = errors.New("client ID is required")
+	errStateMismatch    = errors.New("oauth state mismatch; possible cross-site request, try again")
+	errCodeMissing      = errors.New("oauth redirect did not include a code")
+)
+
+// LoginOptions configures an OAuth loopback login.
+type LoginOptions struct {
+	ClientID     string
+	ClientSecret string
+	// Port for the loopback redirect server; 0 picks a free port. It must
+	// match the redirect URL registered for the OAuth app.
+	Port int
+	// AuthorizeURL overrides DefaultAuthorizeURL (used by tests).
+	AuthorizeURL string
+	// OpenURL is called with the authorize URL once the loopback server is
+	// listening, e.g. to open a browser or print the URL.
+	OpenURL func(authorizeURL string) error
+}
+
+// Login runs the OAuth authorization-code flow: it listens on a loopback
+// redirect URL, hands the authorize URL to opts.OpenURL, waits for ClickUp to
+// redirect back with a code, and exchanges the code for an access token.
+func (s *AuthService) Login(ctx context.Context, opts LoginOptions) (*OAuthTokenResponse, error) {
+	if opts.ClientID == "" {
+		return nil, errClientIDRequired
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", opts.Port))
+	if err != nil {
+		return nil, fmt.Errorf("start loopback server: %w", err)
+	}
+
+	redirectURI := fmt.Sprintf("http://%s/callback", listener.Addr().String())
+
+	state, err := randomState()
+	if err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	type callback struct {
+		code string
+		err  error
+	}
+
+	results := make(chan callback, 1)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		var res callback
+
+		switch {
+		case q.Get("error") != "":
+			res.err = fmt.Errorf("authorization denied: %s", q.Get("error"))
+		// A missing state is rejected too: without it the redirect cannot
+		// be told apart from a forged one.
+		case q.Get("state") != state:
+			res.err = errStateMismatch
+		case q.Get("code") == "":
+			res.err = errCodeMissing
+		default:
+			res.code = q.Get("code")
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+
+		if res.err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprintf(w, "Login failed: %v\n", res.err)
+		} else {
+			_, _ = fmt.Fprintln(w, "Login complete. You can close this window and return to the terminal.")
+		}
+
+		select {
+		case results <- res:
+		default:
+		}
+	})
+
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	go func() { _ = server.Serve(listener) }()
+
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	authorizeURL, err := buildAuthorizeURL(opts.AuthorizeURL, opts.ClientID, redirectURI, state)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.OpenURL != nil {
+		if err := opts.OpenURL(authorizeURL); err != nil {
+			return nil, err
+		}
+	}
+
+	var res callback
+
+	select {
+	case res = <-results:
+	case <-ctx.Done():
+		return nil, fmt.Errorf("wait for oauth redirect: %w", ctx.Err())
+	}
+
+	if res.err != nil {
+		return nil, res.err
+	}
+
+	return s.Token(ctx, OAuthTokenRequest{
+		ClientID:     opts.ClientID,
+		ClientSecret: opts.ClientSecret,
+		Code:         res.code,
+	})
+}
+
+func buildAuthorizeURL(base, clientID, redirectURI, state string) (string, error) {
+	if base == "" {
+		base = DefaultAuthorizeURL
+	}
+
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", fmt.Errorf("parse authorize URL: %w", err)
+	}
+
+	q := u.Query()
+	q.Set("client_id", clientID)
+	q.Set("redirect_uri", redirectURI)
+	q.Set("state", state)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+func randomState() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate oauth state: %w", err)
+	}
+
+	return hex.EncodeToString(b), nil
+}
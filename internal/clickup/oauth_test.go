@@ -0,0 +1,145 @@
+package clickup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// followRedirect plays the browser: it reads the redirect_uri from the
+// authorize URL and calls it the way ClickUp would after consent.
+func followRedirect(t *testing.T, authorizeURL string, params url.Values) {
+	t.Helper()
+
+	u, err := url.Parse(authorizeURL)
+	if err != nil {
+		t.Fatalf("parse authorize URL: %v", err)
+	}
+
+	redirect, err := url.Parse(u.Query().Get("redirect_uri"))
+	if err != nil {
+		t.Fatalf("parse redirect URI: %v", err)
+	}
+
+	redirect.RawQuery = params.Encode()
+
+	go func() {
+		resp, err := http.Get(redirect.String()) //nolint:noctx // test helper
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+	}()
+}
+
+func TestLogin_ExchangesRedirectCode(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/oauth/token" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+
+		var req OAuthTokenRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+
+		if req.ClientID != "client-1" || req.ClientSecret != "secret-1" || req.Code != "code-xyz" {
+			t.Fatalf("unexpected token request: %+v", req)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(OAuthTokenResponse{AccessToken: "token-abc", TokenType: "Bearer"})
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+
+	var opened string
+
+	result, err := client.Auth().Login(context.Background(), LoginOptions{
+		ClientID:     "client-1",
+		ClientSecret: "secret-1",
+		AuthorizeURL: "https://auth.example.test/api",
+		OpenURL: func(authorizeURL string) error {
+			opened = authorizeURL
+
+			u, _ := url.Parse(authorizeURL)
+			followRedirect(t, authorizeURL, url.Values{"code": {"code-xyz"}, "state": {u.Query().Get("state")}})
+
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.AccessToken != "token-abc" {
+		t.Fatalf("expected access token token-abc, got %s", result.AccessToken)
+	}
+
+	u, _ := url.Parse(opened)
+	if u.Host != "auth.example.test" || u.Query().Get("client_id") != "client-1" || u.Query().Get("state") == "" {
+		t.Fatalf("unexpected authorize URL: %s", opened)
+	}
+}
+
+func TestLogin_RejectsStateMismatch(t *testing.T) {
+	t.Parallel()
+
+	for name, query := range map[string]url.Values{
+		"forged":  {"code": {"code-xyz"}, "state": {"forged"}},
+		"missing": {"code": {"code-xyz"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &Client{}
+
+			_, err := client.Auth().Login(context.Background(), LoginOptions{
+				ClientID: "client-1",
+				OpenURL: func(authorizeURL string) error {
+					followRedirect(t, authorizeURL, query)
+					return nil
+				},
+			})
+			if !errors.Is(err, errStateMismatch) {
+				t.Fatalf("expected state mismatch, got %v", err)
+			}
+		})
+	}
+}
+
+func TestLogin_ReportsDeniedConsent(t *testing.T) {
+	t.Parallel()
+
+	client := &Client{}
+
+	_, err := client.Auth().Login(context.Background(), LoginOptions{
+		ClientID: "client-1",
+		OpenURL: func(authorizeURL string) error {
+			followRedirect(t, authorizeURL, url.Values{"error": {"access_denied"}})
+			return nil
+		},
+	})
+	if err == nil || err.Error() != "authorization denied: access_denied" {
+		t.Fatalf("expected denied error, got %v", err)
+	}
+}
+
+func TestLogin_TimesOutWithoutRedirect(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	client := &Client{}
+
+	_, err := client.Auth().Login(ctx, LoginOptions{ClientID: "client-1"})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
@@ -0,0 +1,83 @@
+package clickup
+
+import (
+	"context"
+	"iter"
+)
+
+// PageFunc fetches one page of results by zero-based page number and reports
+// whether it was the last page.
+type PageFunc[T any] func(ctx context.Context, page int) (items []T, lastPage bool, err error)
+
+// CursorFunc fetches one page of results starting at cursor ("" for the first
+// page) and returns the cursor of the next page ("" when there is none).
+type CursorFunc[T any] func(ctx context.Context, cursor string) (items []T, next string, err error)
+
+// Pages walks page-number pagination, yielding every item in order. Iteration
+// stops after the last page, an empty page, the first error, or when ctx is
+// cancelled; errors are yielded once with a zero item.
+func Pages[T any](ctx context.Context, fetch PageFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		for page := 0; ; page++ {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			items, lastPage, err := fetch(ctx, page)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if lastPage || len(items) == 0 {
+				return
+			}
+		}
+	}
+}
+
+// Cursor walks cursor pagination, yielding every item in order. Iteration
+// stops when no next cursor is returned, the cursor stops advancing, on the
+// first error, or when ctx is cancelled.
+func Cursor[T any](ctx context.Context, fetch CursorFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var (
+			zero   T
+			cursor string
+		)
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			items, next, err := fetch(ctx, cursor)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if next == "" || next == cursor {
+				return
+			}
+
+			cursor = next
+		}
+	}
+}
@@ -0,0 +1,337 @@
+package clickup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestPages_StopsAtLastPage(t *testing.T) {
+	t.Parallel()
+
+	var fetched []int
+
+	seq := Pages(context.Background(), func(_ context.Context, page int) ([]int, bool, error) {
+		fetched = append(fetched, page)
+		return []int{page * 10, page*10 + 1}, page == 2, nil
+	})
+
+	var got []int
+
+	for item, err := range seq {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got = append(got, item)
+	}
+
+	if fmt.Sprint(got) != "[0 1 10 11 20 21]" {
+		t.Fatalf("unexpected items: %v", got)
+	}
+
+	if fmt.Sprint(fetched) != "[0 1 2]" {
+		t.Fatalf("unexpected pages fetched: %v", fetched)
+	}
+}
+
+func TestPages_StopsAtEmptyPage(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+
+	seq := Pages(context.Background(), func(_ context.Context, page int) ([]string, bool, error) {
+		calls++
+		if page == 1 {
+			return nil, false, nil
+		}
+
+		return []string{"a"}, false, nil
+	})
+
+	for _, err := range seq {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if calls != 2 {
+		t.Fatalf("expected 2 fetches, got %d", calls)
+	}
+}
+
+func TestPages_YieldsErrorAndStops(t *testing.T) {
+	t.Parallel()
+
+	wantErr := errors.New("boom")
+
+	seq := Pages(context.Background(), func(_ context.Context, page int) ([]int, bool, error) {
+		if page == 1 {
+			return nil, false, wantErr
+		}
+
+		return []int{1}, false, nil
+	})
+
+	var items, errs int
+
+	for _, err := range seq {
+		if err != nil {
+			if !errors.Is(err, wantErr) {
+				t.Fatalf("expected %v, got %v", wantErr, err)
+			}
+
+			errs++
+
+			continue
+		}
+
+		items++
+	}
+
+	if items != 1 || errs != 1 {
+		t.Fatalf("expected 1 item and 1 error, got %d items and %d errors", items, errs)
+	}
+}
+
+func TestPages_EarlyBreakStopsFetching(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+
+	seq := Pages(context.Background(), func(_ context.Context, _ int) ([]int, bool, error) {
+		calls++
+		return []int{1, 2, 3}, false, nil
+	})
+
+	for range seq {
+		break
+	}
+
+	if calls != 1 {
+		t.Fatalf("expected 1 fetch, got %d", calls)
+	}
+}
+
+func TestPages_RespectsContextCancellation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	seq := Pages(ctx, func(_ context.Context, _ int) ([]int, bool, error) {
+		cancel()
+		return []int{1}, false, nil
+	})
+
+	var lastErr error
+	for _, err := range seq {
+		lastErr = err
+	}
+
+	if !errors.Is(lastErr, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", lastErr)
+	}
+}
+
+func TestCursor_FollowsNextToken(t *testing.T) {
+	t.Parallel()
+
+	pages := map[string]struct {
+		items []string
+		next  string
+	}{
+		"":   {items: []string{"a", "b"}, next: "c1"},
+		"c1": {items: []string{"c"}, next: "c2"},
+		"c2": {items: []string{"d"}, next: ""},
+	}
+
+	seq := Cursor(context.Background(), func(_ context.Context, cursor string) ([]string, string, error) {
+		p := pages[cursor]
+		return p.items, p.next, nil
+	})
+
+	var got []string
+
+	for item, err := range seq {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got = append(got, item)
+	}
+
+	if fmt.Sprint(got) != "[a b c d]" {
+		t.Fatalf("unexpected items: %v", got)
+	}
+}
+
+func TestCursor_StopsWhenCursorRepeats(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+
+	seq := Cursor(context.Background(), func(_ context.Context, _ string) ([]int, string, error) {
+		calls++
+		return []int{calls}, "same", nil
+	})
+
+	for range seq {
+	}
+
+	if calls != 2 {
+		t.Fatalf("expected 2 fetches, got %d", calls)
+	}
+}
+
+func TestTasksIter_WalksPagesUntilLastPage(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/list/list-1/task" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil {
+			t.Fatalf("expected page query param, got %q", r.URL.Query().Get("page"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(TasksListResponse{
+			Tasks:    []Task{{ID: fmt.Sprintf("task-%d", page)}},
+			LastPage: page == 1,
+		})
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+
+	var ids []string
+
+	for task, err := range client.Tasks().Iter(context.Background(), "list-1", "", "") {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		ids = append(ids, task.ID)
+	}
+
+	if fmt.Sprint(ids) != "[task-0 task-1]" {
+		t.Fatalf("unexpected tasks: %v", ids)
+	}
+}
+
+func TestSearchIter_StartsAtRequestedPage(t *testing.T) {
+	t.Parallel()
+
+	var pages []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages = append(pages, r.URL.Query().Get("page"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(FilteredTeamTasksResponse{
+			Tasks:    []Task{{ID: "t"}},
+			LastPage: len(pages) == 2,
+		})
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+
+	for _, err := range client.Tasks().SearchIter(context.Background(), "team-1", FilteredTeamTasksParams{Page: 3}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if fmt.Sprint(pages) != "[3 4]" {
+		t.Fatalf("unexpected pages requested: %v", pages)
+	}
+}
+
+func TestChatMessagesIter_FollowsNextPageToken(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := ChatMessagesResponse{}
+
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			resp.Data = []ChatMessage{{ID: "msg-1"}}
+			resp.Pagination = &ChatPagination{NextPageToken: "next-1"}
+		case "next-1":
+			resp.Data = []ChatMessage{{ID: "msg-2"}}
+		default:
+			t.Fatalf("unexpected cursor %q", r.URL.Query().Get("cursor"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.workspaceID = "ws-1"
+
+	var ids []string
+
+	for msg, err := range client.Chat().MessagesIter(context.Background(), "chan-1", 0) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		ids = append(ids, msg.ID)
+	}
+
+	if fmt.Sprint(ids) != "[msg-1 msg-2]" {
+		t.Fatalf("unexpected messages: %v", ids)
+	}
+}
+
+func TestCommentsIterAll_PagesBackUntilEmpty(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		var body string
+
+		switch q.Get("start") + "/" + q.Get("start_id") {
+		case "/":
+			body = `{"comments":[{"id":"3","date":"300"},{"id":"2","date":"200"}]}`
+		case "200/2":
+			// The page repeats the comment it starts from.
+			body = `{"comments":[{"id":"2","date":"200"},{"id":"1","date":"100"}]}`
+		case "100/1":
+			body = `{"comments":[]}`
+		default:
+			t.Fatalf("unexpected page %s", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+
+	var ids []string
+
+	for c, err := range client.Comments().IterAll(context.Background(), "task-1") {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		ids = append(ids, c.ID.String())
+	}
+
+	if fmt.Sprint(ids) != "[3 2 1]" {
+		t.Fatalf("unexpected comments: %v", ids)
+	}
+}
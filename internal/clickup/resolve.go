@@ -0,0 +1,438 @@
+package clickup
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/builtbyrobben/clickup-cli/internal/api"
+)
+
+// Kinds of things a reference can resolve to.
+const (
+	KindSpace  = "space"
+	KindFolder = "folder"
+	KindList   = "list"
+	KindTask   = "task"
+	KindUser   = "user"
+)
+
+var (
+	numericID = regexp.MustCompile(`^\d+$`)
+	// View IDs embed their parent: 4-{space}, 5-{folder} or 6-{list}, then
+	// a view number, e.g. /v/l/6-901234567-1.
+	viewParentID = regexp.MustCompile(`^([456])-(\d+)-\d+$`)
+)
+
+// Match is a space, folder, list, task or user a reference resolved to.
+type Match struct {
+	Kind string
+	ID   string
+	// Path is the human name: "Space/Folder/List" for hierarchy items,
+	// "username <email>" for users.
+	Path string
+
+	segments []string
+}
+
+// AmbiguousError reports a name that matches more than one item.
+type AmbiguousError struct {
+	Kind    string
+	Input   string
+	Matches []Match
+}
+
+func (e *AmbiguousError) Error() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s %q is ambiguous; it matches:", e.Kind, e.Input)
+
+	for _, m := range e.Matches {
+		fmt.Fprintf(&b, "\n  %s (%s)", m.Path, m.ID)
+	}
+
+	b.WriteString("\npass an ID, a URL or a longer path")
+
+	return b.String()
+}
+
+// Resolver turns names, paths ("Engineering/Sprint 42"), emails, @usernames
+// and ClickUp URLs into canonical IDs. Plain numeric IDs are returned
+// without any request. Names are looked up by walking spaces, folders and
+// lists through the client, so the walk is served by the hierarchy cache,
+// and each level is fetched at most once per Resolver.
+type Resolver struct {
+	client *Client
+	teamID func() (string, error)
+
+	// Choose picks one of several matches for an ambiguous name. When nil,
+	// ambiguous names fail with an *AmbiguousError.
+	Choose func(*AmbiguousError) (Match, error)
+
+	mu      sync.Mutex
+	spaces  []Match
+	folders []Match
+	lists   []Match
+	users   []Match
+}
+
+// NewResolver returns a Resolver for the workspace teamID returns. teamID is
+// only called when a name has to be looked up.
+func NewResolver(client *Client, teamID func() (string, error)) *Resolver {
+	return &Resolver{client: client, teamID: teamID}
+}
+
+// Space resolves a space ID, name or URL.
+func (r *Resolver) Space(ctx context.Context, ref string) (string, error) {
+	return r.resolve(ctx, KindSpace, ref, r.loadSpaces)
+}
+
+// Folder resolves a folder ID, name, "Space/Folder" path or URL.
+func (r *Resolver) Folder(ctx context.Context, ref string) (string, error) {
+	return r.resolve(ctx, KindFolder, ref, r.loadFolders)
+}
+
+// List resolves a list ID, name, "Space/Folder/List" path (or any part of
+// it ending in the list name, such as "Space/List") or URL.
+func (r *Resolver) List(ctx context.Context, ref string) (string, error) {
+	return r.resolve(ctx, KindList, ref, r.loadLists)
+}
+
+// Task resolves a task URL to its ID; anything else is taken as an ID. Custom
+// task IDs such as ENG-1234 are kept as they are; the client recognizes them.
+func (r *Resolver) Task(_ context.Context, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+
+	if m, ok := ParseURL(ref); ok {
+		if m.Kind != KindTask {
+			return "", fmt.Errorf("%s is a %s URL, not a task URL", ref, m.Kind)
+		}
+
+		return m.ID, nil
+	}
+
+	return ref, nil
+}
+
+// User resolves a user ID, email or @username (a bare username works too).
+func (r *Resolver) User(ctx context.Context, ref string) (int, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return 0, nil
+	}
+
+	if numericID.MatchString(ref) {
+		return strconv.Atoi(ref)
+	}
+
+	users, err := r.loadUsers(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	name := strings.TrimPrefix(ref, "@")
+	byEmail := !strings.HasPrefix(ref, "@") && strings.Contains(ref, "@")
+
+	var matches []Match
+
+	for _, u := range users {
+		field := u.segments[0]
+		if byEmail {
+			field = u.segments[1]
+		}
+
+		if strings.EqualFold(field, name) {
+			matches = append(matches, u)
+		}
+	}
+
+	m, err := r.pick(KindUser, ref, matches)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(m.ID)
+}
+
+func (r *Resolver) resolve(ctx context.Context, kind, ref string, load func(context.Context) ([]Match, error)) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" || numericID.MatchString(ref) {
+		return ref, nil
+	}
+
+	if m, ok := ParseURL(ref); ok {
+		if m.Kind != kind {
+			return "", fmt.Errorf("%s is a %s URL, not a %s URL", ref, m.Kind, kind)
+		}
+
+		return m.ID, nil
+	}
+
+	items, err := load(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	m, err := r.pick(kind, ref, matchPath(items, ref))
+	if err != nil {
+		return "", err
+	}
+
+	return m.ID, nil
+}
+
+func (r *Resolver) pick(kind, ref string, matches []Match) (Match, error) {
+	switch len(matches) {
+	case 0:
+		return Match{}, fmt.Errorf("no %s matches %q: %w", kind, ref, api.ErrNotFound)
+	case 1:
+		return matches[0], nil
+	}
+
+	amb := &AmbiguousError{Kind: kind, Input: ref, Matches: matches}
+	if r.Choose == nil {
+		return Match{}, amb
+	}
+
+	return r.Choose(amb)
+}
+
+// matchPath returns the items named by the last "/"-separated segment of
+// ref whose path also contains the earlier segments in order, compared
+// case-insensitively: "Engineering/Sprint 42" finds Engineering/Backend/Sprint 42.
+// An item whose own name is ref also matches, for names that contain a slash.
+func matchPath(items []Match, ref string) []Match {
+	want := strings.Split(ref, "/")
+	for i := range want {
+		want[i] = strings.TrimSpace(want[i])
+	}
+
+	var matches []Match
+
+	for _, item := range items {
+		if strings.EqualFold(item.segments[len(item.segments)-1], ref) || pathContains(item.segments, want) {
+			matches = append(matches, item)
+		}
+	}
+
+	return matches
+}
+
+// pathContains reports whether want's last segment is segments' last
+// segment and the rest of want appear in order among the other segments.
+func pathContains(segments, want []string) bool {
+	last := len(segments) - 1
+	if !strings.EqualFold(segments[last], want[len(want)-1]) {
+		return false
+	}
+
+	i := 0
+	for _, seg := range segments[:last] {
+		if i < len(want)-1 && strings.EqualFold(seg, want[i]) {
+			i++
+		}
+	}
+
+	return i == len(want)-1
+}
+
+func newMatch(kind, id string, segments ...string) Match {
+	return Match{Kind: kind, ID: id, Path: strings.Join(segments, "/"), segments: segments}
+}
+
+func (r *Resolver) loadSpaces(ctx context.Context) ([]Match, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.loadSpacesLocked(ctx)
+}
+
+func (r *Resolver) loadSpacesLocked(ctx context.Context) ([]Match, error) {
+	if r.spaces != nil {
+		return r.spaces, nil
+	}
+
+	teamID, err := r.teamID()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.client.Spaces().List(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	spaces := make([]Match, 0, len(result.Spaces))
+	for _, s := range result.Spaces {
+		spaces = append(spaces, newMatch(KindSpace, s.ID, s.Name))
+	}
+
+	r.spaces = spaces
+
+	return spaces, nil
+}
+
+func (r *Resolver) loadFolders(ctx context.Context) ([]Match, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.loadFoldersLocked(ctx)
+}
+
+func (r *Resolver) loadFoldersLocked(ctx context.Context) ([]Match, error) {
+	if r.folders != nil {
+		return r.folders, nil
+	}
+
+	spaces, err := r.loadSpacesLocked(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	folders := []Match{}
+
+	for _, space := range spaces {
+		result, err := r.client.Lists().ListFolders(ctx, space.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, f := range result.Folders {
+			folders = append(folders, newMatch(KindFolder, f.ID, space.Path, f.Name))
+		}
+	}
+
+	r.folders = folders
+
+	return folders, nil
+}
+
+func (r *Resolver) loadLists(ctx context.Context) ([]Match, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.lists != nil {
+		return r.lists, nil
+	}
+
+	folders, err := r.loadFoldersLocked(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lists := []Match{}
+
+	for _, folder := range folders {
+		result, err := r.client.Lists().ListByFolder(ctx, folder.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, l := range result.Lists {
+			lists = append(lists, newMatch(KindList, l.ID, append(folder.segments[:2:2], l.Name)...))
+		}
+	}
+
+	for _, space := range r.spaces {
+		result, err := r.client.Lists().ListFolderless(ctx, space.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, l := range result.Lists {
+			lists = append(lists, newMatch(KindList, l.ID, space.Path, l.Name))
+		}
+	}
+
+	r.lists = lists
+
+	return lists, nil
+}
+
+func (r *Resolver) loadUsers(ctx context.Context) ([]Match, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.users != nil {
+		return r.users, nil
+	}
+
+	teamID, err := r.teamID()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.client.Members().List(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]Match, 0, len(result.Members))
+	for _, m := range result.Members {
+		u := m.User
+		users = append(users, Match{
+			Kind:     KindUser,
+			ID:       strconv.Itoa(u.ID),
+			Path:     fmt.Sprintf("%s <%s>", u.Username, u.Email),
+			segments: []string{u.Username, u.Email},
+		})
+	}
+
+	r.users = users
+
+	return users, nil
+}
+
+// ParseURL extracts what a ClickUp app URL points at: tasks
+// (/t/{id} or /t/{team}/{custom-id}), lists (/{team}/v/li/{id}), folders
+// (/{team}/v/f/{id}), spaces (/{team}/v/s/{id}) and views, which resolve to
+// their parent space, folder or list (/{team}/v/l/6-{list}-1).
+func ParseURL(raw string) (Match, bool) {
+	if strings.HasPrefix(raw, "app.clickup.com/") {
+		raw = "https://" + raw
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || !isClickUpHost(u.Hostname()) {
+		return Match{}, false
+	}
+
+	parts := strings.FieldsFunc(u.Path, func(r rune) bool { return r == '/' })
+
+	if len(parts) >= 2 && parts[0] == "t" {
+		return Match{Kind: KindTask, ID: parts[len(parts)-1]}, true
+	}
+
+	// /{team}/v/{type}/{id}[/...]
+	if len(parts) < 4 || parts[1] != "v" {
+		return Match{}, false
+	}
+
+	typ, id := parts[2], parts[3]
+
+	switch typ {
+	case "li":
+		return Match{Kind: KindList, ID: id}, true
+	case "f":
+		return Match{Kind: KindFolder, ID: id}, true
+	case "s":
+		return Match{Kind: KindSpace, ID: id}, true
+	}
+
+	if m := viewParentID.FindStringSubmatch(id); m != nil {
+		kind := map[string]string{"4": KindSpace, "5": KindFolder, "6": KindList}[m[1]]
+		return Match{Kind: kind, ID: m[2]}, true
+	}
+
+	return Match{}, false
+}
+
+// isClickUpHost reports whether host is clickup.com or one of its subdomains.
+func isClickUpHost(host string) bool {
+	host = strings.ToLower(host)
+	return host == "clickup.com" || strings.HasSuffix(host, ".clickup.com")
+}
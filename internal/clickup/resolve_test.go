@@ -0,0 +1,254 @@
+package clickup
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/builtbyrobben/clickup-cli/internal/api"
+)
+
+// newHierarchyServer serves a workspace with two spaces: Engineering holds
+// a Backend folder and a folderless list, Ops holds a list with a clashing
+// name.
+func newHierarchyServer(t *testing.T, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	bodies := map[string]string{
+		"/v2/team/t1/space":  `{"spaces":[{"id":"1","name":"Engineering"},{"id":"2","name":"Ops"}]}`,
+		"/v2/space/1/folder": `{"folders":[{"id":"10","name":"Backend"}]}`,
+		"/v2/space/2/folder": `{"folders":[]}`,
+		"/v2/folder/10/list": `{"lists":[{"id":"100","name":"Sprint 42"},{"id":"101","name":"Bugs"}]}`,
+		"/v2/space/1/list":   `{"lists":[{"id":"102","name":"Roadmap"}]}`,
+		"/v2/space/2/list":   `{"lists":[{"id":"200","name":"Bugs"}]}`,
+		"/v2/team/t1":        `{"team":{"members":[{"user":{"id":7,"username":"ada","email":"ada@example.com"}},{"user":{"id":8,"username":"Grace Hopper","email":"grace@example.com"}}]}}`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests != nil {
+			requests.Add(1)
+		}
+
+		body, ok := bodies[r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func teamT1() (string, error) { return "t1", nil }
+
+func TestResolver_List(t *testing.T) {
+	t.Parallel()
+
+	resolver := NewResolver(newTestClient(newHierarchyServer(t, nil)), teamT1)
+
+	tests := []struct {
+		ref  string
+		want string
+	}{
+		{"901234", "901234"},
+		{"Sprint 42", "100"},
+		{"sprint 42", "100"},
+		{"Backend/Sprint 42", "100"},
+		{"Engineering/Backend/Sprint 42", "100"},
+		{"engineering/sprint 42", "100"},
+		{"Engineering/Roadmap", "102"},
+		{"Ops/Bugs", "200"},
+		{"https://app.clickup.com/9012/v/li/555", "555"},
+		{"https://app.clickup.com/9012/v/l/6-556-1", "556"},
+	}
+
+	for _, tt := range tests {
+		got, err := resolver.List(context.Background(), tt.ref)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", tt.ref, err)
+		}
+
+		if got != tt.want {
+			t.Fatalf("%q: expected %s, got %s", tt.ref, tt.want, got)
+		}
+	}
+}
+
+func TestResolver_ListAmbiguous(t *testing.T) {
+	t.Parallel()
+
+	resolver := NewResolver(newTestClient(newHierarchyServer(t, nil)), teamT1)
+
+	_, err := resolver.List(context.Background(), "Bugs")
+
+	var amb *AmbiguousError
+	if !errors.As(err, &amb) {
+		t.Fatalf("expected AmbiguousError, got %v", err)
+	}
+
+	if len(amb.Matches) != 2 || amb.Matches[0].Path != "Engineering/Backend/Bugs" || amb.Matches[1].Path != "Ops/Bugs" {
+		t.Fatalf("unexpected matches: %+v", amb.Matches)
+	}
+
+	resolver.Choose = func(amb *AmbiguousError) (Match, error) { return amb.Matches[1], nil }
+
+	got, err := resolver.List(context.Background(), "Bugs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got != "200" {
+		t.Fatalf("expected chosen list 200, got %s", got)
+	}
+}
+
+func TestResolver_NotFoundAndWrongKind(t *testing.T) {
+	t.Parallel()
+
+	resolver := NewResolver(newTestClient(newHierarchyServer(t, nil)), teamT1)
+
+	if _, err := resolver.List(context.Background(), "Nope"); !errors.Is(err, api.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	if _, err := resolver.List(context.Background(), "https://app.clickup.com/9012/v/f/10"); err == nil {
+		t.Fatal("expected error for a folder URL where a list is expected")
+	}
+}
+
+func TestResolver_WalksOncePerResolver(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+
+	resolver := NewResolver(newTestClient(newHierarchyServer(t, &requests)), teamT1)
+
+	for _, ref := range []string{"Sprint 42", "Roadmap"} {
+		if _, err := resolver.List(context.Background(), ref); err != nil {
+			t.Fatalf("%q: unexpected error: %v", ref, err)
+		}
+	}
+
+	if _, err := resolver.Folder(context.Background(), "Engineering/Backend"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := resolver.Space(context.Background(), "Ops"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 1 space list + 2 folder lists + 1 folder's lists + 2 folderless lists.
+	if requests.Load() != 6 {
+		t.Fatalf("expected 6 requests, got %d", requests.Load())
+	}
+}
+
+func TestResolver_SpaceAndFolder(t *testing.T) {
+	t.Parallel()
+
+	resolver := NewResolver(newTestClient(newHierarchyServer(t, nil)), teamT1)
+
+	space, err := resolver.Space(context.Background(), "ops")
+	if err != nil || space != "2" {
+		t.Fatalf("expected space 2, got %q (%v)", space, err)
+	}
+
+	folder, err := resolver.Folder(context.Background(), "Backend")
+	if err != nil || folder != "10" {
+		t.Fatalf("expected folder 10, got %q (%v)", folder, err)
+	}
+}
+
+func TestResolver_User(t *testing.T) {
+	t.Parallel()
+
+	resolver := NewResolver(newTestClient(newHierarchyServer(t, nil)), teamT1)
+
+	tests := []struct {
+		ref  string
+		want int
+	}{
+		{"42", 42},
+		{"@ada", 7},
+		{"ADA@example.com", 7},
+		{"@Grace Hopper", 8},
+		{"grace hopper", 8},
+	}
+
+	for _, tt := range tests {
+		got, err := resolver.User(context.Background(), tt.ref)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", tt.ref, err)
+		}
+
+		if got != tt.want {
+			t.Fatalf("%q: expected %d, got %d", tt.ref, tt.want, got)
+		}
+	}
+
+	if _, err := resolver.User(context.Background(), "@nobody"); !errors.Is(err, api.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestResolver_IDsNeedNoTeam(t *testing.T) {
+	t.Parallel()
+
+	noTeam := func() (string, error) { return "", errors.New("no team") }
+	resolver := NewResolver(newTestClient(newHierarchyServer(t, nil)), noTeam)
+
+	if id, err := resolver.List(context.Background(), "901"); err != nil || id != "901" {
+		t.Fatalf("expected 901, got %q (%v)", id, err)
+	}
+
+	if id, err := resolver.Task(context.Background(), "https://app.clickup.com/t/86abc"); err != nil || id != "86abc" {
+		t.Fatalf("expected 86abc, got %q (%v)", id, err)
+	}
+}
+
+func TestParseURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		raw  string
+		kind string
+		id   string
+	}{
+		{"https://app.clickup.com/t/86abc", KindTask, "86abc"},
+		{"https://app.clickup.com/t/9012/PROJ-12", KindTask, "PROJ-12"},
+		{"app.clickup.com/t/86abc", KindTask, "86abc"},
+		{"https://app.clickup.com/9012/v/li/901234567", KindList, "901234567"},
+		{"https://app.clickup.com/9012/v/f/55/1", KindFolder, "55"},
+		{"https://app.clickup.com/9012/v/s/1", KindSpace, "1"},
+		{"https://app.clickup.com/9012/v/b/6-901234567-2", KindList, "901234567"},
+		{"https://app.clickup.com/9012/v/l/5-55-1", KindFolder, "55"},
+	}
+
+	for _, tt := range tests {
+		m, ok := ParseURL(tt.raw)
+		if !ok || m.Kind != tt.kind || m.ID != tt.id {
+			t.Fatalf("%s: expected %s %s, got %+v (ok=%v)", tt.raw, tt.kind, tt.id, m, ok)
+		}
+	}
+
+	for _, raw := range []string{
+		"Sprint 42",
+		"https://example.com/t/1",
+		"https://evilclickup.com/t/1",
+		"https://app.clickup.com.example.com/t/1",
+		"https://app.clickup.com/9012/home",
+	} {
+		if _, ok := ParseURL(raw); ok {
+			t.Fatalf("%s: expected no match", raw)
+		}
+	}
+}
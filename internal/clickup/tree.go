@@ -0,0 +1,123 @@
+package clickup
+
+import (
+	"context"
+	"fmt"
+	"slices"
+)
+
+// Tree returns a task with its whole subtask hierarchy nested in Subtasks.
+// The direct subtasks come from Get with include_subtasks; the deeper levels
+// come from one workspace search of the lists those live in, including
+// closed tasks, nested by parent in memory.
+func (s *TasksService) Tree(ctx context.Context, teamID, taskID string) (*Task, error) {
+	root, err := s.Get(ctx, taskID, GetTaskParams{IncludeSubtasks: true})
+	if err != nil {
+		return nil, err
+	}
+
+	byParent := map[string][]Task{root.ID: root.Subtasks}
+
+	if len(root.Subtasks) > 0 {
+		params := FilteredTeamTasksParams{ListIDs: subtreeLists(root), Subtasks: true, IncludeClosed: true}
+		for task, err := range s.SearchIter(ctx, teamID, params) {
+			if err != nil {
+				return nil, fmt.Errorf("subtasks of %s: %w", root.ID, err)
+			}
+
+			// The direct subtasks are already known.
+			if task.Parent != "" && task.Parent != root.ID {
+				byParent[task.Parent] = append(byParent[task.Parent], task)
+			}
+		}
+	}
+
+	root.Subtasks = nestSubtasks(root.ID, byParent, map[string]bool{root.ID: true})
+
+	return root, nil
+}
+
+// subtreeLists returns the lists of a task and its direct subtasks, where
+// the deeper subtasks are searched for.
+func subtreeLists(root *Task) []string {
+	var lists []string
+
+	add := func(id string) {
+		if id != "" && !slices.Contains(lists, id) {
+			lists = append(lists, id)
+		}
+	}
+
+	add(root.List.ID)
+
+	for _, task := range root.Subtasks {
+		add(task.List.ID)
+	}
+
+	return lists
+}
+
+// nestSubtasks picks the children of parentID out of byParent and fills in
+// their own subtasks. seen guards against a task turning up twice.
+func nestSubtasks(parentID string, byParent map[string][]Task, seen map[string]bool) []Task {
+	var children []Task
+
+	for _, task := range byParent[parentID] {
+		if seen[task.ID] || (task.Parent != "" && task.Parent != parentID) {
+			continue
+		}
+
+		seen[task.ID] = true
+		children = append(children, task)
+	}
+
+	for i := range children {
+		children[i].Subtasks = nestSubtasks(children[i].ID, byParent, seen)
+	}
+
+	return children
+}
+
+// Walk calls fn for t and then for each of its subtasks, depth first, so
+// parents always come before their children. The root has depth 0.
+func (t *Task) Walk(fn func(task *Task, depth int)) {
+	t.walk(fn, 0)
+}
+
+func (t *Task) walk(fn func(task *Task, depth int), depth int) {
+	fn(t, depth)
+
+	for i := range t.Subtasks {
+		t.Subtasks[i].walk(fn, depth+1)
+	}
+}
+
+// Progress counts the subtasks below t at any depth and how many of them
+// are done.
+func (t *Task) Progress() (done, total int) {
+	t.Walk(func(task *Task, depth int) {
+		if depth == 0 {
+			return
+		}
+
+		total++
+
+		if task.IsDone() {
+			done++
+		}
+	})
+
+	return done, total
+}
+
+// IsDone reports whether the task is in a done or closed status.
+func (t *Task) IsDone() bool {
+	switch t.Status.Type {
+	case "done", "closed":
+		return true
+	case "":
+		return t.DateDone != "" || t.DateClosed != ""
+	}
+
+	return false
+}
@@ -0,0 +1,76 @@
+package clickup
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTasksTree_NestsSubtasksByParent(t *testing.T) {
+	t.Parallel()
+
+	searches := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v2/task/epic":
+			if r.URL.Query().Get("include_subtasks") != "true" {
+				t.Fatalf("expected include_subtasks=true, got %s", r.URL.RawQuery)
+			}
+
+			_, _ = w.Write([]byte(`{"id":"epic","name":"Epic","list":{"id":"L1"},"subtasks":[
+				{"id":"a","name":"A","parent":"epic","list":{"id":"L1"},"status":{"status":"complete","type":"closed"}},
+				{"id":"b","name":"B","parent":"epic","list":{"id":"L2"},"status":{"status":"open","type":"open"}}]}`))
+		case "/v2/team/team-1/task":
+			searches++
+
+			q := r.URL.Query()
+			if q.Get("subtasks") != "true" || q.Get("include_closed") != "true" || q.Has("parent") {
+				t.Fatalf("expected one search with subtasks and include_closed, got %s", r.URL.RawQuery)
+			}
+
+			if lists := q["list_ids[]"]; len(lists) != 2 || lists[0] != "L1" || lists[1] != "L2" {
+				t.Fatalf("expected the lists of the epic and its subtasks, got %v", lists)
+			}
+
+			// The search returns every task of the lists, unrelated ones too.
+			_, _ = w.Write([]byte(`{"tasks":[
+				{"id":"epic","name":"Epic"},
+				{"id":"a","name":"A","parent":"epic"},
+				{"id":"x","name":"X"},
+				{"id":"b1","name":"B1","parent":"b","status":{"status":"done","type":"done"}},
+				{"id":"x1","name":"X1","parent":"x"}],"last_page":true}`))
+		default:
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+
+	root, err := client.Tasks().Tree(context.Background(), "team-1", "epic")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var order []string
+
+	root.Walk(func(task *Task, depth int) {
+		order = append(order, task.ID)
+	})
+
+	if got := len(order); got != 4 || order[0] != "epic" || order[2] != "b" || order[3] != "b1" {
+		t.Fatalf("unexpected walk order %v", order)
+	}
+
+	if done, total := root.Progress(); done != 2 || total != 3 {
+		t.Fatalf("expected 2/3 done, got %d/%d", done, total)
+	}
+
+	if searches != 1 {
+		t.Fatalf("expected one search for the whole tree, got %d", searches)
+	}
+}
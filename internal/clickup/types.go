@@ -4,24 +4,75 @@ import "encoding/json"
 
 // Task represents a ClickUp task.
 type Task struct {
-	ID          string     `json:"id"`
-	Name        string     `json:"name"`
-	Description string     `json:"description,omitempty"`
-	Status      TaskStatus `json:"status"`
-	Priority    *Priority  `json:"priority"`
-	DueDate     string     `json:"due_date,omitempty"`
-	Assignees   []User     `json:"assignees,omitempty"`
-	URL         string     `json:"url,omitempty"`
-	List        ListRef    `json:"list,omitempty"`
-	Folder      FolderRef  `json:"folder,omitempty"`
-	Space       SpaceRef   `json:"space"`
-	Tags        []Tag      `json:"tags,omitempty"`
+	ID                  string            `json:"id"`
+	CustomID            string            `json:"custom_id,omitempty"`
+	Name                string            `json:"name"`
+	Description         string            `json:"description,omitempty"`
+	TextContent         string            `json:"text_content,omitempty"`
+	MarkdownDescription string            `json:"markdown_description,omitempty"`
+	Status              TaskStatus        `json:"status"`
+	Priority            *Priority         `json:"priority"`
+	DueDate             string            `json:"due_date,omitempty"`
+	StartDate           string            `json:"start_date,omitempty"`
+	DateCreated         string            `json:"date_created,omitempty"`
+	DateUpdated         string            `json:"date_updated,omitempty"`
+	DateClosed          string            `json:"date_closed,omitempty"`
+	DateDone            string            `json:"date_done,omitempty"`
+	Creator             *User             `json:"creator,omitempty"`
+	Assignees           []User            `json:"assignees,omitempty"`
+	Watchers            []User            `json:"watchers,omitempty"`
+	URL                 string            `json:"url,omitempty"`
+	List                ListRef           `json:"list,omitempty"`
+	Folder              FolderRef         `json:"folder,omitempty"`
+	Space               SpaceRef          `json:"space"`
+	Tags                []Tag             `json:"tags,omitempty"`
+	Parent              string            `json:"parent,omitempty"`
+	Subtasks            []Task            `json:"subtasks,omitempty"`
+	Checklists          []Checklist       `json:"checklists,omitempty"`
+	Dependencies        []TaskDependency  `json:"dependencies,omitempty"`
+	LinkedTasks         []TaskLink        `json:"linked_tasks,omitempty"`
+	CustomFields        []TaskCustomField `json:"custom_fields,omitempty"`
+	TimeEstimate        int64             `json:"time_estimate,omitempty"`
+	TimeSpent           int64             `json:"time_spent,omitempty"`
+	Points              *float64          `json:"points,omitempty"`
+	Archived            bool              `json:"archived,omitempty"`
+}
+
+// TaskDependency is a "waiting on" relationship: TaskID waits on DependsOn.
+type TaskDependency struct {
+	TaskID    string `json:"task_id"`
+	DependsOn string `json:"depends_on"`
+	Type      int    `json:"type"`
+}
+
+// TaskLink links two tasks without ordering them.
+type TaskLink struct {
+	TaskID string `json:"task_id"`
+	LinkID string `json:"link_id"`
+}
+
+// TaskCustomField is a custom field with its value on a task. Value is
+// absent when the field is unset.
+type TaskCustomField struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	TypeConfig any    `json:"type_config,omitempty"`
+	Value      any    `json:"value,omitempty"`
+	Required   bool   `json:"required"`
+}
+
+// GetTaskParams selects optional parts of a single task read.
+type GetTaskParams struct {
+	IncludeSubtasks            bool
+	IncludeMarkdownDescription bool
 }
 
 // TaskStatus represents a task's status.
 type TaskStatus struct {
 	Status string `json:"status"`
 	Color  string `json:"color,omitempty"`
+	Type   string `json:"type,omitempty"`
 }
 
 // Priority represents a task's priority.
@@ -277,11 +328,50 @@ type SharedResources struct {
 
 // CreateTaskRequest is the request body for creating a task.
 type CreateTaskRequest struct {
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
-	Assignees   []int  `json:"assignees,omitempty"`
-	Priority    *int   `json:"priority,omitempty"`
-	DueDate     string `json:"due_date,omitempty"`
+	Name                      string             `json:"name"`
+	Description               string             `json:"description,omitempty"`
+	MarkdownDescription       string             `json:"markdown_description,omitempty"`
+	Assignees                 []int              `json:"assignees,omitempty"`
+	Tags                      []string           `json:"tags,omitempty"`
+	Status                    string             `json:"status,omitempty"`
+	Priority                  *int               `json:"priority,omitempty"`
+	DueDate                   int64              `json:"due_date,omitempty"`
+	DueDateTime               bool               `json:"due_date_time,omitempty"`
+	StartDate                 int64              `json:"start_date,omitempty"`
+	StartDateTime             bool               `json:"start_date_time,omitempty"`
+	TimeEstimate              int64              `json:"time_estimate,omitempty"`
+	NotifyAll                 bool               `json:"notify_all,omitempty"`
+	Parent                    string             `json:"parent,omitempty"`
+	LinksTo                   string             `json:"links_to,omitempty"`
+	CheckRequiredCustomFields bool               `json:"check_required_custom_fields,omitempty"`
+	CustomFields              []CustomFieldValue `json:"custom_fields,omitempty"`
+}
+
+// CustomFieldValue sets one custom field in a task create request.
+type CustomFieldValue struct {
+	ID    string `json:"id"`
+	Value any    `json:"value"`
+}
+
+// ClearableMillis is a millisecond timestamp in an update request. The zero
+// value leaves the field out of the request; Clear sends null, which
+// removes the date.
+type ClearableMillis struct {
+	Millis int64
+	Clear  bool
+}
+
+// IsZero reports whether the field is left out of the request.
+func (m ClearableMillis) IsZero() bool {
+	return m.Millis == 0 && !m.Clear
+}
+
+func (m ClearableMillis) MarshalJSON() ([]byte, error) {
+	if m.Clear {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(m.Millis)
 }
 
 // TaskAssigneesUpdate is the assignee update payload for the task update endpoint.
@@ -293,10 +383,38 @@ type TaskAssigneesUpdate struct {
 
 // UpdateTaskRequest is the request body for updating a task.
 type UpdateTaskRequest struct {
-	Name      string               `json:"name,omitempty"`
-	Status    string               `json:"status,omitempty"`
-	Assignees *TaskAssigneesUpdate `json:"assignees,omitempty"`
-	Priority  *int                 `json:"priority,omitempty"`
+	Name                string               `json:"name,omitempty"`
+	Description         string               `json:"description,omitempty"`
+	MarkdownDescription string               `json:"markdown_description,omitempty"`
+	Status              string               `json:"status,omitempty"`
+	Assignees           *TaskAssigneesUpdate `json:"assignees,omitempty"`
+	Priority            *int                 `json:"priority,omitempty"`
+	DueDate             ClearableMillis      `json:"due_date,omitzero"`
+	DueDateTime         bool                 `json:"due_date_time,omitempty"`
+	StartDate           ClearableMillis      `json:"start_date,omitzero"`
+	StartDateTime       bool                 `json:"start_date_time,omitempty"`
+	TimeEstimate        int64                `json:"time_estimate,omitempty"`
+	Parent              string               `json:"parent,omitempty"`
+	Archived            *bool                `json:"archived,omitempty"`
+
+	// ClearPriority sends priority null, which removes the priority.
+	ClearPriority bool `json:"-"`
+}
+
+func (r UpdateTaskRequest) MarshalJSON() ([]byte, error) {
+	type plain UpdateTaskRequest
+
+	if !r.ClearPriority {
+		return json.Marshal(plain(r))
+	}
+
+	// The outer field shadows the embedded one and, without omitempty,
+	// encodes as null.
+	return json.Marshal(struct {
+		plain
+
+		Priority *int `json:"priority"`
+	}{plain: plain(r)})
 }
 
 // CreateCommentRequest is the request body for creating a comment.
@@ -311,7 +429,8 @@ type CreateTimeEntryRequest struct {
 
 // TasksListResponse is the response for listing tasks.
 type TasksListResponse struct {
-	Tasks []Task `json:"tasks"`
+	Tasks    []Task `json:"tasks"`
+	LastPage bool   `json:"last_page,omitempty"`
 }
 
 // SpacesListResponse is the response for listing spaces.
@@ -838,11 +957,14 @@ type FilteredTeamTasksParams struct {
 	DateCreatedLt int64    `url:"date_created_lt,omitempty"`
 	DateUpdatedGt int64    `url:"date_updated_gt,omitempty"`
 	DateUpdatedLt int64    `url:"date_updated_lt,omitempty"`
+	Parent        string   `url:"parent,omitempty"`
+	ListIDs       []string `url:"list_ids[],omitempty"`
 }
 
 // FilteredTeamTasksResponse is the response for filtered team tasks search.
 type FilteredTeamTasksResponse struct {
-	Tasks []Task `json:"tasks"`
+	Tasks    []Task `json:"tasks"`
+	LastPage bool   `json:"last_page,omitempty"`
 }
 
 // TimeInStatusResponse contains time-in-status data for a single task.
@@ -1099,7 +1221,8 @@ type ViewResponse struct {
 
 // ViewTasksResponse is the response for getting tasks in a view.
 type ViewTasksResponse struct {
-	Tasks []Task `json:"tasks"`
+	Tasks    []Task `json:"tasks"`
+	LastPage bool   `json:"last_page,omitempty"`
 }
 
 // CreateViewRequest is the request body for creating a view.
@@ -49,14 +49,14 @@ func (cmd *ACLsUpdateCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{"status": "success", "message": "ACL updated"})
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{"status": "success", "message": "ACL updated"})
 	}
 
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "OBJECT_TYPE", "OBJECT_ID"}
 		rows := [][]string{{"success", cmd.ObjectType, cmd.ObjectID}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "ACL updated for %s %s\n", cmd.ObjectType, cmd.ObjectID)
@@ -15,8 +15,8 @@ type AttachmentsCmd struct {
 }
 
 type AttachmentsUploadCmd struct {
-	Task string `name:"task" help:"Task ID" required:""`
-	File string `arg:"" help:"Path to the file to upload" required:""`
+	Task TaskArg `name:"task" help:"Task (ID, custom ID or URL)" required:""`
+	File string  `arg:"" help:"Path to the file to upload" required:""`
 }
 
 func (cmd *AttachmentsUploadCmd) Run(ctx context.Context) error {
@@ -25,18 +25,23 @@ func (cmd *AttachmentsUploadCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	result, err := client.Attachments().Upload(ctx, cmd.Task, cmd.File)
+	taskID, err := cmd.Task.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	result, err := client.Attachments().Upload(ctx, taskID, cmd.File)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "TITLE", "SIZE", "URL"}
 		rows := [][]string{{result.ID, result.Title, fmt.Sprintf("%d", result.Size), result.URL}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Uploaded attachment: %s\n", result.Title)
@@ -68,38 +73,20 @@ func (cmd *AttachmentsListCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
-	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "TITLE", "SIZE", "URL"}
-		rows := make([][]string, 0, len(result.Attachments))
-		for _, att := range result.Attachments {
-			rows = append(rows, []string{att.ID, att.Title, fmt.Sprintf("%d", att.Size), att.URL})
-		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	if len(result.Attachments) == 0 {
-		fmt.Fprintln(os.Stderr, "No attachments found")
-		return nil
-	}
-
-	fmt.Fprintf(os.Stderr, "Found %d attachments\n\n", len(result.Attachments))
-
+	headers := []string{"ID", "TITLE", "SIZE", "URL"}
+	rows := make([][]string, 0, len(result.Attachments))
 	for _, att := range result.Attachments {
-		fmt.Printf("ID: %s\n", att.ID)
-		fmt.Printf("  Title: %s\n", att.Title)
-		fmt.Printf("  URL: %s\n", att.URL)
-		fmt.Printf("  Size: %d bytes\n", att.Size)
-
-		if att.Extension != "" {
-			fmt.Printf("  Extension: %s\n", att.Extension)
-		}
+		rows = append(rows, []string{att.ID, att.Title, fmt.Sprintf("%d", att.Size), att.URL})
+	}
 
-		fmt.Println()
+	if !reportRows(ctx, len(rows), "attachments") {
+		return nil
 	}
 
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
 type AttachmentsCreateCmd struct {
@@ -120,12 +107,12 @@ func (cmd *AttachmentsCreateCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "TITLE", "SIZE", "URL"}
 		rows := [][]string{{result.ID, result.Title, fmt.Sprintf("%d", result.Size), result.URL}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Created attachment: %s\n", result.Title)
@@ -2,8 +2,8 @@ package cmd
 
 import (
 	"context"
-	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/builtbyrobben/clickup-cli/internal/clickup"
 	"github.com/builtbyrobben/clickup-cli/internal/outfmt"
@@ -14,69 +14,70 @@ type AuditLogsCmd struct {
 }
 
 type AuditLogsQueryCmd struct {
-	StartDate int64  `help:"Start date (Unix timestamp in milliseconds)"`
-	EndDate   int64  `help:"End date (Unix timestamp in milliseconds)"`
-	EventType string `help:"Filter by event type"`
-	UserID    string `help:"Filter by user ID"`
-	Limit     int    `help:"Maximum number of results" default:"100"`
+	StartDate DateFlag `help:"Start date (e.g. 2026-10-20, \"2026-10-20 17:00\", tomorrow, \"next friday\", eow, +3d)" placeholder:"DATE"`
+	EndDate   DateFlag `help:"End date" placeholder:"DATE"`
+	EventType string   `help:"Filter by event type"`
+	UserID    UserArg  `help:"Filter by user (ID, email or @username)"`
+	Limit     int      `help:"Maximum number of results" default:"100"`
 }
 
 func (cmd *AuditLogsQueryCmd) Run(ctx context.Context) error {
+	startDate, err := cmd.StartDate.Millis(ctx, "--start-date")
+	if err != nil {
+		return err
+	}
+
+	endDate, err := cmd.EndDate.Millis(ctx, "--end-date")
+	if err != nil {
+		return err
+	}
+
 	client, err := getClickUpClient(ctx)
 	if err != nil {
 		return err
 	}
 
+	userID, err := cmd.UserID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	req := clickup.AuditLogQuery{
-		StartDate: cmd.StartDate,
-		EndDate:   cmd.EndDate,
+		StartDate: startDate,
+		EndDate:   endDate,
 		EventType: cmd.EventType,
-		UserID:    cmd.UserID,
 		Limit:     cmd.Limit,
 	}
 
+	if userID != 0 {
+		req.UserID = strconv.Itoa(userID)
+	}
+
 	result, err := client.AuditLogs().Query(ctx, req)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "EVENT_TYPE", "USER_ID", "TIMESTAMP", "RESOURCE_TYPE", "RESOURCE_ID"}
-		var rows [][]string
-		for _, entry := range result.AuditLogs {
-			rows = append(rows, []string{
-				entry.ID,
-				entry.EventType,
-				entry.UserID,
-				string(entry.Timestamp),
-				entry.ResourceType,
-				entry.ResourceID,
-			})
-		}
-
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+	headers := []string{"ID", "EVENT_TYPE", "USER_ID", "TIMESTAMP", "RESOURCE_TYPE", "RESOURCE_ID"}
+	var rows [][]string
+	for _, entry := range result.AuditLogs {
+		rows = append(rows, []string{
+			entry.ID,
+			entry.EventType,
+			entry.UserID,
+			outfmt.Timestamp(ctx, entry.Timestamp),
+			entry.ResourceType,
+			entry.ResourceID,
+		})
 	}
 
-	if len(result.AuditLogs) == 0 {
-		fmt.Fprintln(os.Stderr, "No audit logs found")
+	if !reportRows(ctx, len(rows), "audit logs") {
 		return nil
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d audit logs\n\n", len(result.AuditLogs))
-
-	for _, entry := range result.AuditLogs {
-		printAuditLogEntry(&entry)
-	}
-
-	return nil
-}
-
-func printAuditLogEntry(entry *clickup.AuditLogEntry) {
-	fmt.Printf("%s: %s by user %s at %s\n", entry.ID, entry.EventType, entry.UserID, string(entry.Timestamp))
-	fmt.Printf("  Resource: %s %s\n", entry.ResourceType, entry.ResourceID)
-	fmt.Println()
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
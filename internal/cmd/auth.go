@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
+	"runtime"
 	"strings"
+	"time"
 
 	"golang.org/x/term"
 
@@ -23,6 +26,8 @@ type AuthCmd struct {
 	Remove       AuthRemoveCmd       `cmd:"" help:"Remove stored credentials"`
 	Whoami       AuthWhoamiCmd       `cmd:"" help:"Get the currently authorized user"`
 	Token        AuthTokenCmd        `cmd:"" help:"Exchange OAuth authorization code for access token"`
+	Login        AuthLoginCmd        `cmd:"" help:"Log in with OAuth in the browser and store the access token"`
+	Profiles     AuthProfilesCmd     `cmd:"" help:"Manage named profiles"`
 }
 
 type AuthSetKeyCmd struct {
@@ -74,14 +79,18 @@ func (cmd *AuthSetKeyCmd) Run(ctx context.Context) error {
 		return fmt.Errorf("store API key: %w", err)
 	}
 
+	if err := config.EnsureProfile(config.ActiveProfile()); err != nil {
+		return fmt.Errorf("record profile: %w", err)
+	}
+
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":  "success",
 			"message": "API key stored in keyring",
 		})
 	}
 	if outfmt.IsPlain(ctx) {
-		return outfmt.WritePlain(os.Stdout, []string{"STATUS", "MESSAGE"}, [][]string{{"success", "API key stored in keyring"}})
+		return outfmt.WritePlain(ctx, os.Stdout, []string{"STATUS", "MESSAGE"}, [][]string{{"success", "API key stored in keyring"}})
 	}
 
 	fmt.Fprintln(os.Stderr, "API key stored in keyring")
@@ -103,14 +112,14 @@ func (cmd *AuthSetTeamCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":  "success",
 			"message": "Team ID stored in config",
 			"team_id": cmd.TeamID,
 		})
 	}
 	if outfmt.IsPlain(ctx) {
-		return outfmt.WritePlain(os.Stdout, []string{"STATUS", "TEAM_ID"}, [][]string{{"success", cmd.TeamID}})
+		return outfmt.WritePlain(ctx, os.Stdout, []string{"STATUS", "TEAM_ID"}, [][]string{{"success", cmd.TeamID}})
 	}
 
 	fmt.Fprintf(os.Stderr, "Team ID %s stored in config\n", cmd.TeamID)
@@ -132,14 +141,14 @@ func (cmd *AuthSetWorkspaceCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":       "success",
 			"message":      "Workspace ID stored in config",
 			"workspace_id": cmd.WorkspaceID,
 		})
 	}
 	if outfmt.IsPlain(ctx) {
-		return outfmt.WritePlain(os.Stdout, []string{"STATUS", "WORKSPACE_ID"}, [][]string{{"success", cmd.WorkspaceID}})
+		return outfmt.WritePlain(ctx, os.Stdout, []string{"STATUS", "WORKSPACE_ID"}, [][]string{{"success", cmd.WorkspaceID}})
 	}
 
 	fmt.Fprintf(os.Stderr, "Workspace ID %s stored in config\n", cmd.WorkspaceID)
@@ -164,6 +173,15 @@ func (cmd *AuthStatusCmd) Run(ctx context.Context) error {
 	envKey := os.Getenv("CLICKUP_API_KEY")
 	envOverride := envKey != ""
 
+	var cred secrets.Credential
+
+	switch {
+	case envOverride:
+		cred = secrets.Credential{Kind: secrets.CredentialAPIKey, Token: envKey}
+	case hasKey:
+		cred, _ = store.GetCredential()
+	}
+
 	envTeamID := os.Getenv("CLICKUP_TEAM_ID")
 	cfgTeamID, _ := config.GetTeamID()
 
@@ -187,8 +205,10 @@ func (cmd *AuthStatusCmd) Run(ctx context.Context) error {
 	}
 
 	status := map[string]any{
+		"profile":          config.ActiveProfile(),
 		"has_key":          hasKey,
 		"env_override":     envOverride,
+		"credential_kind":  string(cred.Kind),
 		"storage_backend":  "keyring",
 		"has_team_id":      teamID != "",
 		"has_workspace_id": workspaceID != "",
@@ -206,17 +226,16 @@ func (cmd *AuthStatusCmd) Run(ctx context.Context) error {
 
 	if hasKey && !envOverride {
 		// Show redacted key
-		key, err := store.GetAPIKey()
-		if err == nil && len(key) > 8 {
+		if key := cred.Token; len(key) > 8 {
 			status["key_redacted"] = key[:4] + "..." + key[len(key)-4:]
 		}
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, status)
+		return outfmt.WriteJSON(ctx, os.Stdout, status)
 	}
 	if outfmt.IsPlain(ctx) {
-		headers := []string{"HAS_KEY", "ENV_OVERRIDE", "STORAGE", "HAS_TEAM_ID", "TEAM_ID", "TEAM_SOURCE", "HAS_WORKSPACE_ID", "WORKSPACE_ID", "WORKSPACE_SOURCE"}
+		headers := []string{"PROFILE", "HAS_KEY", "ENV_OVERRIDE", "CREDENTIAL_KIND", "STORAGE", "HAS_TEAM_ID", "TEAM_ID", "TEAM_SOURCE", "HAS_WORKSPACE_ID", "WORKSPACE_ID", "WORKSPACE_SOURCE"}
 		ts := teamSource
 		if teamID == "" {
 			ts = ""
@@ -226,8 +245,10 @@ func (cmd *AuthStatusCmd) Run(ctx context.Context) error {
 			ws = ""
 		}
 		rows := [][]string{{
+			config.ActiveProfile(),
 			fmt.Sprintf("%t", hasKey),
 			fmt.Sprintf("%t", envOverride),
+			string(cred.Kind),
 			"keyring",
 			fmt.Sprintf("%t", teamID != ""),
 			teamID,
@@ -236,15 +257,22 @@ func (cmd *AuthStatusCmd) Run(ctx context.Context) error {
 			workspaceID,
 			ws,
 		}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	// Human-readable output
+	fmt.Fprintf(os.Stdout, "Profile: %s\n", status["profile"])
 	fmt.Fprintf(os.Stdout, "Storage: %s\n", status["storage_backend"])
 
 	switch {
 	case envOverride:
 		fmt.Fprintln(os.Stdout, "API Key: Using CLICKUP_API_KEY environment variable")
+	case hasKey && cred.Kind == secrets.CredentialOAuth:
+		fmt.Fprintln(os.Stdout, "Credential: OAuth token (sent as Bearer)")
+
+		if redacted, ok := status["key_redacted"].(string); ok {
+			fmt.Fprintf(os.Stdout, "Token: %s\n", redacted)
+		}
 	case hasKey:
 		fmt.Fprintln(os.Stdout, "API Key: Authenticated")
 
@@ -253,7 +281,7 @@ func (cmd *AuthStatusCmd) Run(ctx context.Context) error {
 		}
 	default:
 		fmt.Fprintln(os.Stdout, "API Key: Not authenticated")
-		fmt.Fprintln(os.Stderr, "Run: clickup-cli auth set-key --stdin")
+		fmt.Fprintln(os.Stderr, "Run: clickup-cli auth set-key --stdin or clickup-cli auth login --client-id <ID>")
 	}
 
 	if teamID != "" {
@@ -285,13 +313,13 @@ func (cmd *AuthRemoveCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":  "success",
 			"message": "API key removed",
 		})
 	}
 	if outfmt.IsPlain(ctx) {
-		return outfmt.WritePlain(os.Stdout, []string{"STATUS", "MESSAGE"}, [][]string{{"success", "API key removed"}})
+		return outfmt.WritePlain(ctx, os.Stdout, []string{"STATUS", "MESSAGE"}, [][]string{{"success", "API key removed"}})
 	}
 
 	fmt.Fprintln(os.Stderr, "API key removed")
@@ -313,7 +341,7 @@ func (cmd *AuthWhoamiCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "USERNAME", "EMAIL"}
@@ -322,7 +350,7 @@ func (cmd *AuthWhoamiCmd) Run(ctx context.Context) error {
 			result.User.Username,
 			result.User.Email,
 		}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Authenticated as:\n")
@@ -363,12 +391,12 @@ func (cmd *AuthTokenCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ACCESS_TOKEN", "TOKEN_TYPE"}
 		rows := [][]string{{result.AccessToken, result.TokenType}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Token exchange successful\n")
@@ -377,3 +405,260 @@ func (cmd *AuthTokenCmd) Run(ctx context.Context) error {
 
 	return nil
 }
+
+type AuthLoginCmd struct {
+	ClientID     string        `required:"" help:"OAuth client ID"`
+	ClientSecret string        `required:"" help:"OAuth client secret" env:"CLICKUP_CLIENT_SECRET"`
+	Port         int           `help:"Loopback redirect port (0 picks a free port); must match the app's registered redirect URL" default:"0"`
+	NoBrowser    bool          `help:"Print the authorize URL instead of opening a browser"`
+	Timeout      time.Duration `help:"How long to wait for the browser redirect" default:"5m"`
+}
+
+func (cmd *AuthLoginCmd) Run(ctx context.Context) error {
+	// The token exchange is unauthenticated, so no stored credential is needed.
+	opts, err := clientOptions(ctx, "", "")
+	if err != nil {
+		return err
+	}
+
+	client := clickup.NewClient("", opts...)
+
+	ctx, cancel := context.WithTimeout(ctx, cmd.Timeout)
+	defer cancel()
+
+	result, err := client.Auth().Login(ctx, clickup.LoginOptions{
+		ClientID:     cmd.ClientID,
+		ClientSecret: cmd.ClientSecret,
+		Port:         cmd.Port,
+		OpenURL: func(authorizeURL string) error {
+			fmt.Fprintf(os.Stderr, "Open this URL to authorize clickup-cli:\n\n  %s\n\n", authorizeURL)
+
+			if !cmd.NoBrowser {
+				if err := openBrowser(authorizeURL); err != nil {
+					fmt.Fprintf(os.Stderr, "Could not open a browser (%v); open the URL manually.\n", err)
+				}
+			}
+
+			fmt.Fprintln(os.Stderr, "Waiting for authorization...")
+
+			return nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	store, err := secrets.OpenDefault()
+	if err != nil {
+		return fmt.Errorf("open credential store: %w", err)
+	}
+
+	if err := store.SetOAuthToken(result.AccessToken); err != nil {
+		return fmt.Errorf("store OAuth token: %w", err)
+	}
+
+	if err := config.EnsureProfile(config.ActiveProfile()); err != nil {
+		return fmt.Errorf("record profile: %w", err)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
+			"status":          "success",
+			"message":         "OAuth token stored in keyring",
+			"credential_kind": string(secrets.CredentialOAuth),
+		})
+	}
+	if outfmt.IsPlain(ctx) {
+		return outfmt.WritePlain(ctx, os.Stdout, []string{"STATUS", "CREDENTIAL_KIND"}, [][]string{{"success", string(secrets.CredentialOAuth)}})
+	}
+
+	fmt.Fprintln(os.Stderr, "Logged in; OAuth token stored in keyring")
+
+	return nil
+}
+
+// openBrowser opens url in the user's default browser.
+func openBrowser(url string) error {
+	var c *exec.Cmd
+
+	switch runtime.GOOS {
+	case "darwin":
+		c = exec.Command("open", url)
+	case "windows":
+		c = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		c = exec.Command("xdg-open", url)
+	}
+
+	if err := c.Start(); err != nil {
+		return fmt.Errorf("open browser: %w", err)
+	}
+
+	go func() { _ = c.Wait() }()
+
+	return nil
+}
+
+type AuthProfilesCmd struct {
+	List   AuthProfilesListCmd   `cmd:"" help:"List profiles"`
+	Use    AuthProfilesUseCmd    `cmd:"" help:"Set the default profile"`
+	Delete AuthProfilesDeleteCmd `cmd:"" help:"Delete a profile and its stored credentials"`
+}
+
+type AuthProfilesListCmd struct{}
+
+type profileJSON struct {
+	Name           string `json:"name"`
+	Active         bool   `json:"active"`
+	TeamID         string `json:"team_id,omitempty"`
+	WorkspaceID    string `json:"workspace_id,omitempty"`
+	CredentialKind string `json:"credential_kind,omitempty"`
+}
+
+func (cmd *AuthProfilesListCmd) Run(ctx context.Context) error {
+	names, err := config.ListProfiles()
+	if err != nil {
+		return fmt.Errorf("read profiles: %w", err)
+	}
+
+	active := config.ActiveProfile()
+	profiles := make([]profileJSON, 0, len(names))
+
+	for _, name := range names {
+		data, err := config.GetProfile(name)
+		if err != nil {
+			return fmt.Errorf("read profile %s: %w", name, err)
+		}
+
+		p := profileJSON{
+			Name:        name,
+			Active:      name == active,
+			TeamID:      data.TeamID,
+			WorkspaceID: data.WorkspaceID,
+		}
+
+		if store, err := secrets.OpenProfile(name); err == nil {
+			if cred, err := store.GetCredential(); err == nil {
+				p.CredentialKind = string(cred.Kind)
+			}
+		}
+
+		profiles = append(profiles, p)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]any{"profiles": profiles, "active": active})
+	}
+	if outfmt.IsPlain(ctx) {
+		headers := []string{"NAME", "ACTIVE", "TEAM_ID", "WORKSPACE_ID", "CREDENTIAL_KIND"}
+		var rows [][]string
+		for _, p := range profiles {
+			rows = append(rows, []string{p.Name, fmt.Sprintf("%t", p.Active), p.TeamID, p.WorkspaceID, p.CredentialKind})
+		}
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
+	}
+
+	if len(profiles) == 0 {
+		fmt.Fprintln(os.Stderr, "No profiles configured")
+		fmt.Fprintln(os.Stderr, "Run: clickup-cli --profile <NAME> auth set-key --stdin")
+		return nil
+	}
+
+	for _, p := range profiles {
+		marker := " "
+		if p.Active {
+			marker = "*"
+		}
+
+		credential := p.CredentialKind
+		if credential == "" {
+			credential = "none"
+		}
+
+		fmt.Printf("%s %s\n", marker, p.Name)
+		fmt.Printf("    Credential: %s\n", credential)
+		if p.TeamID != "" {
+			fmt.Printf("    Team ID: %s\n", p.TeamID)
+		}
+		if p.WorkspaceID != "" {
+			fmt.Printf("    Workspace ID: %s\n", p.WorkspaceID)
+		}
+	}
+
+	return nil
+}
+
+type AuthProfilesUseCmd struct {
+	Name string `arg:"" required:"" help:"Profile name"`
+}
+
+func (cmd *AuthProfilesUseCmd) Run(ctx context.Context) error {
+	if err := config.UseProfile(cmd.Name); err != nil {
+		return fmt.Errorf("switch profile: %w", err)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
+			"status":  "success",
+			"profile": cmd.Name,
+		})
+	}
+	if outfmt.IsPlain(ctx) {
+		return outfmt.WritePlain(ctx, os.Stdout, []string{"STATUS", "PROFILE"}, [][]string{{"success", cmd.Name}})
+	}
+
+	fmt.Fprintf(os.Stderr, "Now using profile %s\n", cmd.Name)
+
+	return nil
+}
+
+type AuthProfilesDeleteCmd struct {
+	Name string `arg:"" required:"" help:"Profile name"`
+}
+
+func (cmd *AuthProfilesDeleteCmd) Run(ctx context.Context) error {
+	if _, err := config.GetProfile(cmd.Name); err != nil {
+		return err
+	}
+
+	// Profiles are local, so --dry-run has no request to intercept.
+	if dryRunEnabled(ctx) {
+		fmt.Fprintf(os.Stderr, "Dry run: would delete profile %s and its stored credentials\n", cmd.Name)
+		return nil
+	}
+
+	if !forceEnabled(ctx) {
+		fmt.Fprintf(os.Stderr, "Warning: This will delete profile %s and its stored credentials\n", cmd.Name)
+		fmt.Fprint(os.Stderr, "Use --force to confirm deletion\n")
+
+		return fmt.Errorf("operation cancelled: use --force to confirm")
+	}
+
+	store, err := secrets.OpenProfile(cmd.Name)
+	if err != nil {
+		return fmt.Errorf("open credential store: %w", err)
+	}
+
+	if err := store.DeleteAPIKey(); err != nil {
+		return fmt.Errorf("remove credentials: %w", err)
+	}
+
+	if err := config.DeleteProfile(cmd.Name); err != nil {
+		return fmt.Errorf("delete profile: %w", err)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
+			"status":  "success",
+			"message": "Profile deleted",
+			"profile": cmd.Name,
+		})
+	}
+	if outfmt.IsPlain(ctx) {
+		return outfmt.WritePlain(ctx, os.Stdout, []string{"STATUS", "PROFILE"}, [][]string{{"success", cmd.Name}})
+	}
+
+	fmt.Fprintf(os.Stderr, "Profile %s deleted\n", cmd.Name)
+
+	return nil
+}
@@ -0,0 +1,488 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"golang.org/x/term"
+
+	"github.com/builtbyrobben/clickup-cli/internal/api"
+	"github.com/builtbyrobben/clickup-cli/internal/clickup"
+	"github.com/builtbyrobben/clickup-cli/internal/outfmt"
+)
+
+// TasksBulkCmd applies one change to many tasks at once.
+type TasksBulkCmd struct {
+	Update TasksBulkUpdateCmd `cmd:"" help:"Update status, priority, dates or archived state of many tasks"`
+	Move   TasksBulkMoveCmd   `cmd:"" help:"Move many tasks to a list"`
+	Tag    TasksBulkTagCmd    `cmd:"" help:"Add or remove tags on many tasks"`
+	Assign TasksBulkAssignCmd `cmd:"" help:"Add or remove assignees on many tasks"`
+	Delete TasksBulkDeleteCmd `cmd:"" help:"Delete many tasks"`
+}
+
+// BulkTaskFlags select the tasks of a bulk command: IDs piped on stdin, IDs
+// in a file, or a workspace search. Each input line holds a task ID or URL as
+// its first field, so --plain task listings can be piped in directly.
+type BulkTaskFlags struct {
+	From        string     `help:"Read task IDs or URLs from this file, one per line (- for stdin; default: stdin when piped)" placeholder:"FILE"`
+	Where       BulkFilter `embed:"" prefix:"where-" group:"Search filter"`
+	Concurrency int        `help:"Number of tasks processed at a time" default:"4"`
+}
+
+// BulkFilter selects tasks with a workspace search instead of a list of IDs.
+type BulkFilter struct {
+	Status        []string  `help:"Select tasks with this status (can be repeated)"`
+	Assignee      []UserArg `help:"Select tasks with this assignee (user ID, email or @username; can be repeated)"`
+	Tag           []string  `help:"Select tasks with this tag (can be repeated)"`
+	List          []ListArg `help:"Select tasks in this list (ID, name, path or URL; can be repeated)"`
+	DueAfter      DateFlag  `help:"Select tasks due after this date" placeholder:"DATE"`
+	DueBefore     DateFlag  `help:"Select tasks due before this date" placeholder:"DATE"`
+	IncludeClosed bool      `help:"Also select closed tasks"`
+}
+
+func (f BulkFilter) empty() bool {
+	return len(f.Status) == 0 && len(f.Assignee) == 0 && len(f.Tag) == 0 && len(f.List) == 0 &&
+		f.DueAfter == "" && f.DueBefore == ""
+}
+
+// bulkResult is the outcome of a bulk operation on one task.
+type bulkResult struct {
+	TaskID string `json:"task_id"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// taskIDs collects the tasks a bulk command applies to.
+func (f *BulkTaskFlags) taskIDs(ctx context.Context, client *clickup.Client) ([]string, error) {
+	if f.Concurrency < 1 {
+		return nil, newUsageError(fmt.Errorf("--concurrency must be at least 1"))
+	}
+
+	if !f.Where.empty() {
+		if f.From != "" {
+			return nil, newUsageError(fmt.Errorf("--from cannot be combined with --where-* filters"))
+		}
+
+		return f.Where.search(ctx, client)
+	}
+
+	var r io.Reader
+
+	switch f.From {
+	case "":
+		if term.IsTerminal(int(os.Stdin.Fd())) {
+			return nil, newUsageError(fmt.Errorf("no tasks given: pipe task IDs on stdin, use --from FILE or a --where-* filter"))
+		}
+
+		r = os.Stdin
+	case "-":
+		r = os.Stdin
+	default:
+		file, err := os.Open(f.From)
+		if err != nil {
+			return nil, fmt.Errorf("open task list: %w", err)
+		}
+		defer file.Close()
+
+		r = file
+	}
+
+	args, err := readTaskArgs(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveTasks(ctx, client, args)
+}
+
+// readTaskArgs reads the first field of each line, skipping blank lines,
+// # comments and an "ID" header.
+func readTaskArgs(r io.Reader) ([]TaskArg, error) {
+	var args []TaskArg
+
+	seen := map[string]bool{}
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") || strings.EqualFold(fields[0], "id") {
+			continue
+		}
+
+		if !seen[fields[0]] {
+			seen[fields[0]] = true
+			args = append(args, TaskArg(fields[0]))
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read task IDs: %w", err)
+	}
+
+	return args, nil
+}
+
+func (f BulkFilter) search(ctx context.Context, client *clickup.Client) ([]string, error) {
+	dueAfter, err := f.DueAfter.Millis(ctx, "--where-due-after")
+	if err != nil {
+		return nil, err
+	}
+
+	dueBefore, err := f.DueBefore.Millis(ctx, "--where-due-before")
+	if err != nil {
+		return nil, err
+	}
+
+	assignees, err := resolveUsers(ctx, client, f.Assignee)
+	if err != nil {
+		return nil, err
+	}
+
+	listIDs := make([]string, 0, len(f.List))
+
+	for _, list := range f.List {
+		id, err := list.Resolve(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+
+		listIDs = append(listIDs, id)
+	}
+
+	teamID, err := getTeamID()
+	if err != nil {
+		return nil, err
+	}
+
+	params := clickup.FilteredTeamTasksParams{
+		Statuses:      f.Status,
+		Assignees:     assignees,
+		Tags:          f.Tag,
+		ListIDs:       listIDs,
+		DueDateGt:     dueAfter,
+		DueDateLt:     dueBefore,
+		IncludeClosed: f.IncludeClosed,
+		Subtasks:      true,
+	}
+
+	var ids []string
+
+	for task, err := range client.Tasks().SearchIter(ctx, teamID, params) {
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, task.ID)
+	}
+
+	return ids, nil
+}
+
+// runBulk applies fn to every task and reports each outcome in input order.
+func runBulk(ctx context.Context, flags *BulkTaskFlags, ids []string, fn func(ctx context.Context, taskID string) error) error {
+	concurrency := flags.Concurrency
+
+	// A dry run prints each request; one at a time keeps them in input order.
+	if dryRunEnabled(ctx) {
+		concurrency = 1
+	}
+
+	results := bulkResults(ctx, concurrency, ids, fn)
+
+	if dryRunEnabled(ctx) {
+		return api.ErrDryRun
+	}
+
+	return writeBulkResults(ctx, os.Stdout, results)
+}
+
+// bulkResults applies fn to every task on a pool of workers and returns the
+// outcomes in input order. The client's shared rate limiter and retries pace
+// the workers; once ctx is cancelled the remaining tasks are not started.
+func bulkResults(ctx context.Context, concurrency int, ids []string, fn func(ctx context.Context, taskID string) error) []bulkResult {
+	results := make([]bulkResult, len(ids))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for range min(concurrency, len(ids)) {
+		wg.Go(func() {
+			for i := range jobs {
+				results[i] = bulkResult{TaskID: ids[i], Status: "ok"}
+
+				err := ctx.Err()
+				if err == nil {
+					err = fn(ctx, ids[i])
+				}
+
+				if err != nil && !errors.Is(err, api.ErrDryRun) {
+					results[i].Status = "failed"
+					results[i].Error = err.Error()
+				}
+			}
+		})
+	}
+
+	for i := range ids {
+		jobs <- i
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// writeBulkResults writes the per-task report to w and fails when any task
+// did.
+func writeBulkResults(ctx context.Context, w io.Writer, results []bulkResult) error {
+	failed := 0
+
+	for _, r := range results {
+		if r.Status != "ok" {
+			failed++
+		}
+	}
+
+	var err error
+
+	switch {
+	case outfmt.IsJSON(ctx):
+		err = outfmt.WriteJSON(ctx, w, map[string]any{
+			"results":   results,
+			"succeeded": len(results) - failed,
+			"failed":    failed,
+		})
+	default:
+		headers := []string{"TASK_ID", "STATUS", "ERROR"}
+		rows := make([][]outfmt.Cell, 0, len(results))
+
+		for _, r := range results {
+			status := outfmt.Cell{Text: r.Status}
+			if r.Status != "ok" {
+				status.Color = "#d33d44"
+			}
+
+			rows = append(rows, []outfmt.Cell{{Text: r.TaskID}, status, {Text: r.Error}})
+		}
+
+		err = outfmt.WriteRows(ctx, w, headers, rows)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d tasks failed", failed, len(results))
+	}
+
+	if !outfmt.IsJSON(ctx) && !outfmt.IsPlain(ctx) {
+		fmt.Fprintf(os.Stderr, "%d tasks done\n", len(results))
+	}
+
+	return nil
+}
+
+type TasksBulkUpdateCmd struct {
+	BulkTaskFlags `embed:""`
+
+	Status     string   `help:"New status"`
+	Priority   *int     `help:"New priority (1=urgent, 2=high, 3=normal, 4=low)"`
+	Due        DateFlag `help:"New due date" placeholder:"DATE"`
+	ClearDue   bool     `help:"Remove the due date"`
+	Start      DateFlag `help:"New start date" placeholder:"DATE"`
+	ClearStart bool     `help:"Remove the start date"`
+	Archived   *bool    `help:"Archive (true) or unarchive (false) the tasks"`
+}
+
+func (cmd *TasksBulkUpdateCmd) Run(ctx context.Context) error {
+	if cmd.ClearDue && cmd.Due != "" {
+		return newUsageError(fmt.Errorf("--due and --clear-due are mutually exclusive"))
+	}
+
+	if cmd.ClearStart && cmd.Start != "" {
+		return newUsageError(fmt.Errorf("--start and --clear-start are mutually exclusive"))
+	}
+
+	due, err := cmd.Due.Millis(ctx, "--due")
+	if err != nil {
+		return err
+	}
+
+	start, err := cmd.Start.Millis(ctx, "--start")
+	if err != nil {
+		return err
+	}
+
+	req := clickup.UpdateTaskRequest{
+		Status:        cmd.Status,
+		Priority:      cmd.Priority,
+		DueDate:       clickup.ClearableMillis{Millis: due, Clear: cmd.ClearDue},
+		DueDateTime:   hasTimeOfDay(ctx, due),
+		StartDate:     clickup.ClearableMillis{Millis: start, Clear: cmd.ClearStart},
+		StartDateTime: hasTimeOfDay(ctx, start),
+		Archived:      cmd.Archived,
+	}
+
+	if req.Status == "" && req.Priority == nil && req.DueDate.IsZero() && req.StartDate.IsZero() && req.Archived == nil {
+		return newUsageError(fmt.Errorf("nothing to update: give --status, --priority, --due, --start, --archived or a --clear-* flag"))
+	}
+
+	client, err := getClickUpClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	ids, err := cmd.taskIDs(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	return runBulk(ctx, &cmd.BulkTaskFlags, ids, func(ctx context.Context, taskID string) error {
+		_, err := client.Tasks().Update(ctx, taskID, req)
+		return err
+	})
+}
+
+type TasksBulkMoveCmd struct {
+	BulkTaskFlags `embed:""`
+
+	ListID ListArg `name:"list" required:"" help:"Target list (ID, name, path or URL)"`
+}
+
+func (cmd *TasksBulkMoveCmd) Run(ctx context.Context) error {
+	client, err := getClickUpClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	listID, err := cmd.ListID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	ids, err := cmd.taskIDs(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	return runBulk(ctx, &cmd.BulkTaskFlags, ids, func(ctx context.Context, taskID string) error {
+		_, err := client.Tasks().Move(ctx, taskID, listID)
+		return err
+	})
+}
+
+type TasksBulkTagCmd struct {
+	BulkTaskFlags `embed:""`
+
+	Tag    []string `required:"" help:"Tag name (can be repeated)"`
+	Remove bool     `help:"Remove the tags instead of adding them"`
+}
+
+func (cmd *TasksBulkTagCmd) Run(ctx context.Context) error {
+	client, err := getClickUpClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	ids, err := cmd.taskIDs(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	tags := client.Tags()
+
+	apply := tags.AddToTask
+	if cmd.Remove {
+		apply = tags.RemoveFromTask
+	}
+
+	return runBulk(ctx, &cmd.BulkTaskFlags, ids, func(ctx context.Context, taskID string) error {
+		for _, tag := range cmd.Tag {
+			if err := apply(ctx, taskID, tag); err != nil && !errors.Is(err, api.ErrDryRun) {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+type TasksBulkAssignCmd struct {
+	BulkTaskFlags `embed:""`
+
+	Add    []UserArg `help:"Add this assignee (user ID, email or @username; can be repeated)"`
+	Remove []UserArg `help:"Remove this assignee (user ID, email or @username; can be repeated)"`
+}
+
+func (cmd *TasksBulkAssignCmd) Run(ctx context.Context) error {
+	if len(cmd.Add) == 0 && len(cmd.Remove) == 0 {
+		return newUsageError(fmt.Errorf("give at least one --add or --remove"))
+	}
+
+	client, err := getClickUpClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	add, err := resolveUsers(ctx, client, cmd.Add)
+	if err != nil {
+		return err
+	}
+
+	remove, err := resolveUsers(ctx, client, cmd.Remove)
+	if err != nil {
+		return err
+	}
+
+	ids, err := cmd.taskIDs(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	req := clickup.UpdateTaskRequest{
+		Assignees: &clickup.TaskAssigneesUpdate{Add: add, Rem: remove},
+	}
+
+	return runBulk(ctx, &cmd.BulkTaskFlags, ids, func(ctx context.Context, taskID string) error {
+		_, err := client.Tasks().Update(ctx, taskID, req)
+		return err
+	})
+}
+
+type TasksBulkDeleteCmd struct {
+	BulkTaskFlags `embed:""`
+}
+
+func (cmd *TasksBulkDeleteCmd) Run(ctx context.Context) error {
+	client, err := getClickUpClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	ids, err := cmd.taskIDs(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if !forceEnabled(ctx) {
+		fmt.Fprintf(os.Stderr, "This will permanently delete %d tasks:\n", len(ids))
+
+		for _, id := range ids {
+			fmt.Fprintf(os.Stderr, "  %s\n", id)
+		}
+
+		fmt.Fprint(os.Stderr, "\nUse --force to confirm deletion\n")
+
+		return fmt.Errorf("operation cancelled: use --force to confirm")
+	}
+
+	return runBulk(ctx, &cmd.BulkTaskFlags, ids, client.Tasks().Delete)
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/builtbyrobben/clickup-cli/internal/api"
+	"github.com/builtbyrobben/clickup-cli/internal/outfmt"
+)
+
+func TestReadTaskArgs(t *testing.T) {
+	t.Parallel()
+
+	input := strings.Join([]string{
+		"ID\tNAME\tSTATUS",
+		"86abc\tWrite docs\topen",
+		"",
+		"# skipped",
+		"  ENG-12  ",
+		"86abc\tduplicate",
+		"https://app.clickup.com/t/86def",
+	}, "\n")
+
+	args, err := readTaskArgs(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []TaskArg{"86abc", "ENG-12", "https://app.clickup.com/t/86def"}
+	if !slices.Equal(args, want) {
+		t.Fatalf("expected %v, got %v", want, args)
+	}
+}
+
+func TestBulkResults_KeepInputOrder(t *testing.T) {
+	t.Parallel()
+
+	ids := []string{"t1", "t2", "t3", "t4", "t5", "t6"}
+
+	// Earlier tasks finish last, so completion order is the reverse of input.
+	results := bulkResults(context.Background(), 3, ids, func(_ context.Context, taskID string) error {
+		i := slices.Index(ids, taskID)
+		time.Sleep(time.Duration(len(ids)-i) * 5 * time.Millisecond)
+
+		switch taskID {
+		case "t2":
+			return errors.New("boom")
+		case "t4":
+			return api.ErrDryRun
+		}
+
+		return nil
+	})
+
+	got := make([]string, 0, len(results))
+	for _, r := range results {
+		got = append(got, r.TaskID+":"+r.Status)
+	}
+
+	want := []string{"t1:ok", "t2:failed", "t3:ok", "t4:ok", "t5:ok", "t6:ok"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	if results[1].Error != "boom" {
+		t.Fatalf("expected the failure to be reported, got %+v", results[1])
+	}
+}
+
+func TestBulkResults_SkipsTasksAfterCancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	results := bulkResults(ctx, 1, []string{"t1", "t2"}, func(context.Context, string) error {
+		cancel()
+		return nil
+	})
+
+	if results[0].Status != "ok" || results[1].Status != "failed" || !strings.Contains(results[1].Error, "canceled") {
+		t.Fatalf("expected the second task to be skipped, got %+v", results)
+	}
+}
+
+func TestWriteBulkResults_FailureExitCode(t *testing.T) {
+	t.Parallel()
+
+	ctx := outfmt.WithMode(context.Background(), outfmt.Mode{Format: outfmt.FormatJSON})
+	results := []bulkResult{
+		{TaskID: "t1", Status: "ok"},
+		{TaskID: "t2", Status: "failed", Error: "boom"},
+	}
+
+	var buf bytes.Buffer
+
+	err := writeBulkResults(ctx, &buf, results)
+	if err == nil || err.Error() != "1 of 2 tasks failed" {
+		t.Fatalf("expected a failure summary, got %v", err)
+	}
+
+	var exitErr *ExitError
+	if !errors.As(withExitCode(err), &exitErr) || exitErr.Code != exitCodeError {
+		t.Fatalf("expected exit code %d, got %v", exitCodeError, withExitCode(err))
+	}
+
+	var report struct {
+		Results   []bulkResult `json:"results"`
+		Succeeded int          `json:"succeeded"`
+		Failed    int          `json:"failed"`
+	}
+
+	if err := json.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatalf("parse report: %v", err)
+	}
+
+	if report.Succeeded != 1 || report.Failed != 1 || fmt.Sprint(report.Results) != fmt.Sprint(results) {
+		t.Fatalf("unexpected report: %s", buf.String())
+	}
+
+	if err := writeBulkResults(ctx, &bytes.Buffer{}, results[:1]); err != nil {
+		t.Fatalf("expected success without failures, got %v", err)
+	}
+}
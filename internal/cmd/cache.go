@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/builtbyrobben/clickup-cli/internal/api"
+	"github.com/builtbyrobben/clickup-cli/internal/config"
+	"github.com/builtbyrobben/clickup-cli/internal/outfmt"
+)
+
+type CacheCmd struct {
+	Clear CacheClearCmd `cmd:"" help:"Delete all cached responses"`
+	Stats CacheStatsCmd `cmd:"" help:"Show cache usage"`
+}
+
+type CacheClearCmd struct{}
+
+func (cmd *CacheClearCmd) Run(ctx context.Context) error {
+	files, err := cacheFiles()
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("remove cache file: %w", err)
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]any{
+			"cleared": true,
+			"files":   len(files),
+		})
+	}
+
+	fmt.Fprintf(os.Stderr, "Cleared %d cache files\n", len(files))
+
+	return nil
+}
+
+type CacheStatsCmd struct{}
+
+type cacheStatsJSON struct {
+	Path    string         `json:"path"`
+	Entries int            `json:"entries"`
+	Fresh   int            `json:"fresh"`
+	Expired int            `json:"expired"`
+	Bytes   int64          `json:"bytes"`
+	Hits    int            `json:"hits"`
+	Misses  int            `json:"misses"`
+	ByKind  map[string]int `json:"by_kind"`
+}
+
+func (cmd *CacheStatsCmd) Run(ctx context.Context) error {
+	files, err := cacheFiles()
+	if err != nil {
+		return err
+	}
+
+	stats := make([]cacheStatsJSON, 0, len(files))
+
+	for _, file := range files {
+		s := api.NewCache(file, nil, nil).Stats()
+		stats = append(stats, cacheStatsJSON(s))
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]any{"caches": stats})
+	}
+
+	if outfmt.IsPlain(ctx) {
+		headers := []string{"FILE", "ENTRIES", "FRESH", "EXPIRED", "BYTES", "HITS", "MISSES", "KINDS"}
+		var rows [][]string
+		for _, s := range stats {
+			rows = append(rows, []string{
+				filepath.Base(s.Path),
+				strconv.Itoa(s.Entries),
+				strconv.Itoa(s.Fresh),
+				strconv.Itoa(s.Expired),
+				strconv.FormatInt(s.Bytes, 10),
+				strconv.Itoa(s.Hits),
+				strconv.Itoa(s.Misses),
+				formatKindCounts(s.ByKind),
+			})
+		}
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
+	}
+
+	if len(stats) == 0 {
+		fmt.Fprintln(os.Stderr, "Cache is empty")
+		return nil
+	}
+
+	for _, s := range stats {
+		fmt.Printf("File: %s\n", s.Path)
+		fmt.Printf("  Entries: %d (%d fresh, %d expired)\n", s.Entries, s.Fresh, s.Expired)
+		fmt.Printf("  Size: %d bytes\n", s.Bytes)
+		fmt.Printf("  Hits: %d  Misses: %d\n", s.Hits, s.Misses)
+		if len(s.ByKind) > 0 {
+			fmt.Printf("  Kinds: %s\n", formatKindCounts(s.ByKind))
+		}
+		fmt.Println()
+	}
+
+	return nil
+}
+
+// cacheFiles lists the cache files in the cache directory.
+func cacheFiles() ([]string, error) {
+	dir, err := config.CacheDir()
+	if err != nil {
+		return nil, fmt.Errorf("resolve cache directory: %w", err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("list cache files: %w", err)
+	}
+
+	return files, nil
+}
+
+func formatKindCounts(byKind map[string]int) string {
+	kinds := make([]string, 0, len(byKind))
+	for kind := range byKind {
+		kinds = append(kinds, kind)
+	}
+
+	slices.Sort(kinds)
+
+	parts := make([]string, 0, len(kinds))
+	for _, kind := range kinds {
+		parts = append(parts, fmt.Sprintf("%s=%d", kind, byKind[kind]))
+	}
+
+	return strings.Join(parts, ",")
+}
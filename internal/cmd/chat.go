@@ -46,32 +46,20 @@ func (cmd *ChatChannelsCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
-	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "NAME", "TYPE", "MEMBER_COUNT"}
-		rows := make([][]string, 0, len(result.Channels))
-		for _, ch := range result.Channels {
-			rows = append(rows, []string{ch.ID, ch.Name, ch.Type, fmt.Sprintf("%d", ch.MemberCount)})
-		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	if len(result.Channels) == 0 {
-		fmt.Fprintln(os.Stderr, "No channels found")
-		return nil
+	headers := []string{"ID", "NAME", "TYPE", "MEMBER_COUNT"}
+	rows := make([][]string, 0, len(result.Channels))
+	for _, ch := range result.Channels {
+		rows = append(rows, []string{ch.ID, ch.Name, ch.Type, fmt.Sprintf("%d", ch.MemberCount)})
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d channels\n\n", len(result.Channels))
-
-	for _, ch := range result.Channels {
-		fmt.Printf("ID: %s\n", ch.ID)
-		fmt.Printf("  Name: %s\n", ch.Name)
-		fmt.Printf("  Type: %s\n", ch.Type)
-		fmt.Printf("  Members: %d\n\n", ch.MemberCount)
+	if !reportRows(ctx, len(rows), "channels") {
+		return nil
 	}
 
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
 type ChatChannelCmd struct {
@@ -90,12 +78,12 @@ func (cmd *ChatChannelCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME", "TYPE", "MEMBER_COUNT"}
 		rows := [][]string{{result.ID, result.Name, result.Type, fmt.Sprintf("%d", result.MemberCount)}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Printf("ID: %s\n", result.ID)
@@ -122,36 +110,20 @@ func (cmd *ChatChannelFollowersCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
-	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "USERNAME", "EMAIL"}
-		rows := make([][]string, 0, len(result.Members))
-		for _, u := range result.Members {
-			rows = append(rows, []string{fmt.Sprintf("%d", u.ID), u.Username, u.Email})
-		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
-	}
-
-	if len(result.Members) == 0 {
-		fmt.Fprintln(os.Stderr, "No followers found")
-		return nil
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d followers\n\n", len(result.Members))
-
+	headers := []string{"ID", "USERNAME", "EMAIL"}
+	rows := make([][]string, 0, len(result.Members))
 	for _, u := range result.Members {
-		fmt.Printf("ID: %d\n", u.ID)
-		fmt.Printf("  Username: %s\n", u.Username)
-
-		if u.Email != "" {
-			fmt.Printf("  Email: %s\n", u.Email)
-		}
+		rows = append(rows, []string{fmt.Sprintf("%d", u.ID), u.Username, u.Email})
+	}
 
-		fmt.Println()
+	if !reportRows(ctx, len(rows), "followers") {
+		return nil
 	}
 
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
 type ChatChannelMembersCmd struct {
@@ -170,36 +142,20 @@ func (cmd *ChatChannelMembersCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
-	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "USERNAME", "EMAIL"}
-		rows := make([][]string, 0, len(result.Members))
-		for _, u := range result.Members {
-			rows = append(rows, []string{fmt.Sprintf("%d", u.ID), u.Username, u.Email})
-		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
-	}
-
-	if len(result.Members) == 0 {
-		fmt.Fprintln(os.Stderr, "No members found")
-		return nil
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d members\n\n", len(result.Members))
-
+	headers := []string{"ID", "USERNAME", "EMAIL"}
+	rows := make([][]string, 0, len(result.Members))
 	for _, u := range result.Members {
-		fmt.Printf("ID: %d\n", u.ID)
-		fmt.Printf("  Username: %s\n", u.Username)
-
-		if u.Email != "" {
-			fmt.Printf("  Email: %s\n", u.Email)
-		}
+		rows = append(rows, []string{fmt.Sprintf("%d", u.ID), u.Username, u.Email})
+	}
 
-		fmt.Println()
+	if !reportRows(ctx, len(rows), "members") {
+		return nil
 	}
 
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
 type ChatCreateChannelCmd struct {
@@ -218,7 +174,7 @@ func (cmd *ChatCreateChannelCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	fmt.Fprintf(os.Stderr, "Created channel: %s\n", result.Name)
@@ -245,7 +201,7 @@ func (cmd *ChatCreateDMCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	fmt.Fprintf(os.Stderr, "Created direct message channel\n")
@@ -276,7 +232,7 @@ func (cmd *ChatCreateLocChannelCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	fmt.Fprintf(os.Stderr, "Created location channel: %s\n", result.Name)
@@ -302,7 +258,7 @@ func (cmd *ChatUpdateChannelCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	fmt.Fprintf(os.Stderr, "Updated channel: %s\n", result.Name)
@@ -339,8 +295,9 @@ func (cmd *ChatDeleteChannelCmd) Run(ctx context.Context) error {
 
 type ChatMessagesCmd struct {
 	ChannelID string `arg:"" help:"Channel ID" required:""`
-	Limit     int    `name:"limit" short:"l" help:"Maximum messages to return"`
+	Limit     int    `name:"limit" short:"l" help:"Maximum messages to return (with --all: total across pages)"`
 	Cursor    string `name:"cursor" short:"c" help:"Pagination cursor"`
+	All       bool   `help:"Follow next_page_token and fetch every message"`
 }
 
 func (cmd *ChatMessagesCmd) Run(ctx context.Context) error {
@@ -349,45 +306,85 @@ func (cmd *ChatMessagesCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	if cmd.All {
+		return cmd.runAll(ctx, client)
+	}
+
 	result, err := client.Chat().ListMessages(ctx, cmd.ChannelID, cmd.Limit, cmd.Cursor)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "USER_ID", "TYPE", "DATE", "CONTENT", "REPLIES"}
-		rows := make([][]string, 0, len(result.Data))
-		for _, msg := range result.Data {
-			rows = append(rows, []string{msg.ID, msg.UserID, msg.Type, string(msg.DateCreated), msg.Content, fmt.Sprintf("%d", msg.RepliesCount)})
-		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+
+	headers := []string{"ID", "USER_ID", "TYPE", "DATE", "CONTENT", "REPLIES"}
+	rows := make([][]string, 0, len(result.Data))
+	for _, msg := range result.Data {
+		rows = append(rows, []string{msg.ID, msg.UserID, msg.Type, outfmt.Timestamp(ctx, msg.DateCreated), msg.Content, fmt.Sprintf("%d", msg.RepliesCount)})
 	}
 
-	if len(result.Data) == 0 {
-		fmt.Fprintln(os.Stderr, "No messages found")
+	if !reportRows(ctx, len(rows), "messages") {
 		return nil
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d messages\n\n", len(result.Data))
-
-	for _, msg := range result.Data {
-		fmt.Printf("ID: %s\n", msg.ID)
-		fmt.Printf("  User: %s\n", msg.UserID)
-		fmt.Printf("  Type: %s\n", msg.Type)
-		fmt.Printf("  Content: %s\n", msg.Content)
-		fmt.Printf("  Replies: %d\n\n", msg.RepliesCount)
+	if err := outfmt.WritePlain(ctx, os.Stdout, headers, rows); err != nil {
+		return err
 	}
 
-	if result.Pagination != nil && result.Pagination.NextPageToken != "" {
+	if !outfmt.IsPlain(ctx) && result.Pagination != nil && result.Pagination.NextPageToken != "" {
 		fmt.Fprintf(os.Stderr, "Next page cursor: %s\n", result.Pagination.NextPageToken)
 	}
 
 	return nil
 }
 
+// runAll streams every message in the channel in the active output mode.
+func (cmd *ChatMessagesCmd) runAll(ctx context.Context, client *clickup.Client) error {
+	if cmd.Cursor != "" {
+		return fmt.Errorf("--cursor cannot be combined with --all")
+	}
+
+	seq := client.Chat().MessagesIter(ctx, cmd.ChannelID, 0)
+
+	if outfmt.IsJSON(ctx) {
+		return writeJSONStream(ctx, seq, cmd.Limit, func(messages []clickup.ChatMessage) any {
+			return clickup.ChatMessagesResponse{Data: messages}
+		})
+	}
+
+	rw := outfmt.NewWriter(ctx, os.Stdout)
+
+	if err := rw.WriteHeader([]string{"ID", "USER_ID", "TYPE", "DATE", "CONTENT", "REPLIES"}); err != nil {
+		return err
+	}
+
+	n, err := streamPages(seq, cmd.Limit, func(msg clickup.ChatMessage) error {
+		return rw.WriteRow([]string{msg.ID, msg.UserID, msg.Type, outfmt.Timestamp(ctx, msg.DateCreated), msg.Content, fmt.Sprintf("%d", msg.RepliesCount)})
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := rw.Flush(); err != nil {
+		return err
+	}
+
+	if outfmt.IsPlain(ctx) {
+		return nil
+	}
+
+	if n == 0 {
+		fmt.Fprintln(os.Stderr, "No messages found")
+		return nil
+	}
+
+	fmt.Fprintf(os.Stderr, "Found %d messages\n", n)
+
+	return nil
+}
+
 type ChatSendCmd struct {
 	ChannelID string `arg:"" help:"Channel ID" required:""`
 	Text      string `name:"text" short:"t" help:"Message text" required:""`
@@ -405,7 +402,7 @@ func (cmd *ChatSendCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	fmt.Fprintf(os.Stderr, "Sent message\n")
@@ -431,7 +428,7 @@ func (cmd *ChatUpdateMessageCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	fmt.Fprintf(os.Stderr, "Updated message: %s\n", result.ID)
@@ -482,31 +479,20 @@ func (cmd *ChatReactionsCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
-	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "USER_ID", "EMOJI", "DATE"}
-		rows := make([][]string, 0, len(result.Reactions))
-		for _, r := range result.Reactions {
-			rows = append(rows, []string{r.ID, r.UserID, r.Reaction, string(r.DateCreated)})
-		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	if len(result.Reactions) == 0 {
-		fmt.Fprintln(os.Stderr, "No reactions found")
-		return nil
+	headers := []string{"ID", "USER_ID", "EMOJI", "DATE"}
+	rows := make([][]string, 0, len(result.Reactions))
+	for _, r := range result.Reactions {
+		rows = append(rows, []string{r.ID, r.UserID, r.Reaction, outfmt.Timestamp(ctx, r.DateCreated)})
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d reactions\n\n", len(result.Reactions))
-
-	for _, r := range result.Reactions {
-		fmt.Printf("ID: %s\n", r.ID)
-		fmt.Printf("  User: %s\n", r.UserID)
-		fmt.Printf("  Emoji: %s\n\n", r.Reaction)
+	if !reportRows(ctx, len(rows), "reactions") {
+		return nil
 	}
 
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
 type ChatReactCmd struct {
@@ -526,7 +512,7 @@ func (cmd *ChatReactCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	fmt.Fprintf(os.Stderr, "Added reaction: %s\n", result.Reaction)
@@ -572,31 +558,20 @@ func (cmd *ChatRepliesCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
-	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "USER_ID", "CONTENT"}
-		rows := make([][]string, 0, len(result.Data))
-		for _, r := range result.Data {
-			rows = append(rows, []string{r.ID, r.UserID, r.Content})
-		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	if len(result.Data) == 0 {
-		fmt.Fprintln(os.Stderr, "No replies found")
-		return nil
+	headers := []string{"ID", "USER_ID", "CONTENT"}
+	rows := make([][]string, 0, len(result.Data))
+	for _, r := range result.Data {
+		rows = append(rows, []string{r.ID, r.UserID, r.Content})
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d replies\n\n", len(result.Data))
-
-	for _, r := range result.Data {
-		fmt.Printf("ID: %s\n", r.ID)
-		fmt.Printf("  User: %s\n", r.UserID)
-		fmt.Printf("  Content: %s\n\n", r.Content)
+	if !reportRows(ctx, len(rows), "replies") {
+		return nil
 	}
 
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
 type ChatReplyCmd struct {
@@ -616,7 +591,7 @@ func (cmd *ChatReplyCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	fmt.Fprintf(os.Stderr, "Created reply\n")
@@ -641,34 +616,18 @@ func (cmd *ChatTaggedUsersCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
-	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "USERNAME", "EMAIL"}
-		rows := make([][]string, 0, len(result.Users))
-		for _, u := range result.Users {
-			rows = append(rows, []string{fmt.Sprintf("%d", u.ID), u.Username, u.Email})
-		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
-	}
-
-	if len(result.Users) == 0 {
-		fmt.Fprintln(os.Stderr, "No tagged users found")
-		return nil
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d tagged users\n\n", len(result.Users))
-
+	headers := []string{"ID", "USERNAME", "EMAIL"}
+	rows := make([][]string, 0, len(result.Users))
 	for _, u := range result.Users {
-		fmt.Printf("ID: %d\n", u.ID)
-		fmt.Printf("  Username: %s\n", u.Username)
-
-		if u.Email != "" {
-			fmt.Printf("  Email: %s\n", u.Email)
-		}
+		rows = append(rows, []string{fmt.Sprintf("%d", u.ID), u.Username, u.Email})
+	}
 
-		fmt.Println()
+	if !reportRows(ctx, len(rows), "tagged users") {
+		return nil
 	}
 
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
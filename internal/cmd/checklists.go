@@ -20,8 +20,8 @@ type ChecklistsCmd struct {
 }
 
 type ChecklistsCreateCmd struct {
-	TaskID string `required:"" help:"Task ID"`
-	Name   string `arg:"" required:"" help:"Checklist name"`
+	TaskID TaskArg `required:"" help:"Task (ID, custom ID or URL)"`
+	Name   string  `arg:"" required:"" help:"Checklist name"`
 }
 
 func (cmd *ChecklistsCreateCmd) Run(ctx context.Context) error {
@@ -30,22 +30,27 @@ func (cmd *ChecklistsCreateCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	req := clickup.CreateChecklistRequest{Name: cmd.Name}
 
-	result, err := client.Checklists().Create(ctx, cmd.TaskID, req)
+	result, err := client.Checklists().Create(ctx, taskID, req)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME", "ITEM_COUNT"}
 		rows := [][]string{{result.ID, result.Name, strconv.Itoa(len(result.Items))}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Checklist created (ID: %s)\n", result.ID)
@@ -77,14 +82,14 @@ func (cmd *ChecklistsUpdateCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME", "POSITION"}
 		rows := [][]string{{result.ID, result.Name, strconv.Itoa(result.OrderIndex)}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Checklist updated (ID: %s)\n", result.ID)
@@ -115,7 +120,7 @@ func (cmd *ChecklistsDeleteCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":       "success",
 			"message":      "Checklist deleted",
 			"checklist_id": cmd.ChecklistID,
@@ -126,7 +131,7 @@ func (cmd *ChecklistsDeleteCmd) Run(ctx context.Context) error {
 		headers := []string{"STATUS", "CHECKLIST_ID"}
 		rows := [][]string{{"success", cmd.ChecklistID}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Checklist %s deleted\n", cmd.ChecklistID)
@@ -135,9 +140,9 @@ func (cmd *ChecklistsDeleteCmd) Run(ctx context.Context) error {
 }
 
 type ChecklistsAddItemCmd struct {
-	ChecklistID string `arg:"" required:"" help:"Checklist ID"`
-	Name        string `arg:"" required:"" help:"Item name"`
-	Assignee    int    `help:"Assignee user ID"`
+	ChecklistID string  `arg:"" required:"" help:"Checklist ID"`
+	Name        string  `arg:"" required:"" help:"Item name"`
+	Assignee    UserArg `help:"Assignee (user ID, email or @username)"`
 }
 
 func (cmd *ChecklistsAddItemCmd) Run(ctx context.Context) error {
@@ -146,9 +151,14 @@ func (cmd *ChecklistsAddItemCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	assignee, err := cmd.Assignee.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	req := clickup.CreateChecklistItemRequest{
 		Name:     cmd.Name,
-		Assignee: cmd.Assignee,
+		Assignee: assignee,
 	}
 
 	result, err := client.Checklists().AddItem(ctx, cmd.ChecklistID, req)
@@ -157,7 +167,7 @@ func (cmd *ChecklistsAddItemCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
@@ -167,7 +177,7 @@ func (cmd *ChecklistsAddItemCmd) Run(ctx context.Context) error {
 			rows = append(rows, []string{result.ID, item.ID, item.Name, strconv.FormatBool(item.Resolved)})
 		}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Item added to checklist %s\n", cmd.ChecklistID)
@@ -183,12 +193,12 @@ func (cmd *ChecklistsAddItemCmd) Run(ctx context.Context) error {
 }
 
 type ChecklistsUpdateItemCmd struct {
-	ChecklistID string `arg:"" required:"" help:"Checklist ID"`
-	ItemID      string `arg:"" required:"" help:"Item ID"`
-	Name        string `help:"New item name"`
-	Resolved    *bool  `help:"Mark as resolved (true/false)"`
-	Assignee    int    `help:"Assignee user ID"`
-	Parent      string `help:"Parent item ID for nesting"`
+	ChecklistID string  `arg:"" required:"" help:"Checklist ID"`
+	ItemID      string  `arg:"" required:"" help:"Item ID"`
+	Name        string  `help:"New item name"`
+	Resolved    *bool   `help:"Mark as resolved (true/false)"`
+	Assignee    UserArg `help:"Assignee (user ID, email or @username)"`
+	Parent      string  `help:"Parent item ID for nesting"`
 }
 
 func (cmd *ChecklistsUpdateItemCmd) Run(ctx context.Context) error {
@@ -197,10 +207,15 @@ func (cmd *ChecklistsUpdateItemCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	assignee, err := cmd.Assignee.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	req := clickup.EditChecklistItemRequest{
 		Name:     cmd.Name,
 		Resolved: cmd.Resolved,
-		Assignee: cmd.Assignee,
+		Assignee: assignee,
 		Parent:   cmd.Parent,
 	}
 
@@ -210,7 +225,7 @@ func (cmd *ChecklistsUpdateItemCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
@@ -220,7 +235,7 @@ func (cmd *ChecklistsUpdateItemCmd) Run(ctx context.Context) error {
 			rows = append(rows, []string{result.ID, item.ID, item.Name, strconv.FormatBool(item.Resolved)})
 		}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Checklist item updated\n")
@@ -262,7 +277,7 @@ func (cmd *ChecklistsDeleteItemCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":       "success",
 			"message":      "Checklist item deleted",
 			"checklist_id": cmd.ChecklistID,
@@ -274,7 +289,7 @@ func (cmd *ChecklistsDeleteItemCmd) Run(ctx context.Context) error {
 		headers := []string{"STATUS", "CHECKLIST_ID", "ITEM_ID"}
 		rows := [][]string{{"success", cmd.ChecklistID, cmd.ItemID}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Item %s deleted from checklist %s\n", cmd.ItemID, cmd.ChecklistID)
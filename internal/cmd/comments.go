@@ -24,7 +24,7 @@ type CommentsCmd struct {
 }
 
 type CommentsListCmd struct {
-	TaskID string `arg:"" required:"" help:"Task ID"`
+	TaskID TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
 }
 
 func (cmd *CommentsListCmd) Run(ctx context.Context) error {
@@ -33,48 +33,36 @@ func (cmd *CommentsListCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	result, err := client.Comments().List(ctx, cmd.TaskID)
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
 	if err != nil {
 		return err
 	}
 
-	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
-	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "USER", "TEXT", "DATE"}
-		var rows [][]string
-		for _, comment := range result.Comments {
-			rows = append(rows, []string{comment.ID.String(), comment.User.Username, comment.Text, comment.Date})
-		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+	result, err := client.Comments().List(ctx, taskID)
+	if err != nil {
+		return err
 	}
 
-	if len(result.Comments) == 0 {
-		fmt.Fprintln(os.Stderr, "No comments found")
-		return nil
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d comments\n\n", len(result.Comments))
-
+	headers := []string{"ID", "USER", "TEXT", "DATE"}
+	var rows [][]string
 	for _, comment := range result.Comments {
-		fmt.Printf("ID: %s\n", comment.ID)
-		fmt.Printf("  User: %s\n", comment.User.Username)
-		fmt.Printf("  Text: %s\n", comment.Text)
-
-		if comment.Date != "" {
-			fmt.Printf("  Date: %s\n", comment.Date)
-		}
+		rows = append(rows, []string{comment.ID.String(), comment.User.Username, comment.Text, outfmt.Timestamp(ctx, comment.Date)})
+	}
 
-		fmt.Println()
+	if !reportRows(ctx, len(rows), "comments") {
+		return nil
 	}
 
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
 type CommentsAddCmd struct {
-	TaskID string `arg:"" required:"" help:"Task ID"`
-	Text   string `arg:"" required:"" help:"Comment text"`
+	TaskID TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
+	Text   string  `arg:"" required:"" help:"Comment text"`
 }
 
 func (cmd *CommentsAddCmd) Run(ctx context.Context) error {
@@ -83,18 +71,23 @@ func (cmd *CommentsAddCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	result, err := client.Comments().Add(ctx, cmd.TaskID, cmd.Text)
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	result, err := client.Comments().Add(ctx, taskID, cmd.Text)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID"}
 		rows := [][]string{{result.ID.String()}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Comment added (ID: %s)\n", result.ID)
@@ -123,12 +116,12 @@ func (cmd *CommentsDeleteCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{"status": "success", "comment_id": cmd.CommentID})
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{"status": "success", "comment_id": cmd.CommentID})
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "COMMENT_ID"}
 		rows := [][]string{{"success", cmd.CommentID}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Comment %s deleted\n", cmd.CommentID)
@@ -137,10 +130,10 @@ func (cmd *CommentsDeleteCmd) Run(ctx context.Context) error {
 }
 
 type CommentsUpdateCmd struct {
-	CommentID string `arg:"" required:"" help:"Comment ID"`
-	Text      string `help:"New comment text"`
-	Resolved  bool   `help:"Mark as resolved"`
-	Assignee  int    `help:"Reassign to user ID"`
+	CommentID string  `arg:"" required:"" help:"Comment ID"`
+	Text      string  `help:"New comment text"`
+	Resolved  bool    `help:"Mark as resolved"`
+	Assignee  UserArg `help:"Reassign to user (ID, email or @username)"`
 }
 
 func (cmd *CommentsUpdateCmd) Run(ctx context.Context) error {
@@ -149,9 +142,14 @@ func (cmd *CommentsUpdateCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	assignee, err := cmd.Assignee.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	req := clickup.UpdateCommentRequest{
 		CommentText: cmd.Text,
-		Assignee:    cmd.Assignee,
+		Assignee:    assignee,
 	}
 
 	if cmd.Resolved {
@@ -164,12 +162,12 @@ func (cmd *CommentsUpdateCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{"status": "success", "comment_id": cmd.CommentID})
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{"status": "success", "comment_id": cmd.CommentID})
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "COMMENT_ID"}
 		rows := [][]string{{"success", cmd.CommentID}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Comment %s updated\n", cmd.CommentID)
@@ -193,15 +191,15 @@ func (cmd *CommentsRepliesCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "USER", "DATE", "TEXT"}
 		var rows [][]string
 		for _, comment := range result.Comments {
-			rows = append(rows, []string{comment.ID.String(), comment.User.Username, comment.Date, comment.Text})
+			rows = append(rows, []string{comment.ID.String(), comment.User.Username, outfmt.Timestamp(ctx, comment.Date), comment.Text})
 		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	if len(result.Comments) == 0 {
@@ -214,7 +212,7 @@ func (cmd *CommentsRepliesCmd) Run(ctx context.Context) error {
 	for _, comment := range result.Comments {
 		fmt.Printf("ID: %s\n", comment.ID)
 		fmt.Printf("  User: %s\n", comment.User.Username)
-		fmt.Printf("  Date: %s\n", comment.Date)
+		fmt.Printf("  Date: %s\n", outfmt.Timestamp(ctx, comment.Date))
 		fmt.Printf("  Text: %s\n", comment.Text)
 		fmt.Println()
 	}
@@ -239,12 +237,12 @@ func (cmd *CommentsReplyCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "TEXT"}
 		rows := [][]string{{result.ID.String(), result.Text}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintln(os.Stderr, "Reply created")
@@ -255,7 +253,7 @@ func (cmd *CommentsReplyCmd) Run(ctx context.Context) error {
 }
 
 type CommentsListCommentsCmd struct {
-	ListID string `arg:"" required:"" help:"List ID"`
+	ListID ListArg `arg:"" required:"" help:"List (ID, name, path or URL)"`
 }
 
 func (cmd *CommentsListCommentsCmd) Run(ctx context.Context) error {
@@ -264,49 +262,37 @@ func (cmd *CommentsListCommentsCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	result, err := client.Comments().ListComments(ctx, cmd.ListID)
+	listID, err := cmd.ListID.Resolve(ctx, client)
 	if err != nil {
 		return err
 	}
 
-	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
-	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "USER", "TEXT", "DATE"}
-		var rows [][]string
-		for _, comment := range result.Comments {
-			rows = append(rows, []string{comment.ID.String(), comment.User.Username, comment.Text, comment.Date})
-		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+	result, err := client.Comments().ListComments(ctx, listID)
+	if err != nil {
+		return err
 	}
 
-	if len(result.Comments) == 0 {
-		fmt.Fprintf(os.Stderr, "No comments on list %s\n", cmd.ListID)
-		return nil
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d comments on list %s\n\n", len(result.Comments), cmd.ListID)
-
+	headers := []string{"ID", "USER", "TEXT", "DATE"}
+	var rows [][]string
 	for _, comment := range result.Comments {
-		fmt.Printf("ID: %s\n", comment.ID)
-		fmt.Printf("  User: %s\n", comment.User.Username)
-		fmt.Printf("  Text: %s\n", comment.Text)
-
-		if comment.Date != "" {
-			fmt.Printf("  Date: %s\n", comment.Date)
-		}
+		rows = append(rows, []string{comment.ID.String(), comment.User.Username, comment.Text, outfmt.Timestamp(ctx, comment.Date)})
+	}
 
-		fmt.Println()
+	if !reportRows(ctx, len(rows), "comments on list "+listID) {
+		return nil
 	}
 
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
 type CommentsAddListCmd struct {
-	ListID   string `arg:"" required:"" help:"List ID"`
-	Text     string `arg:"" required:"" help:"Comment text"`
-	Assignee int    `help:"Assign to user ID"`
+	ListID   ListArg `arg:"" required:"" help:"List (ID, name, path or URL)"`
+	Text     string  `arg:"" required:"" help:"Comment text"`
+	Assignee UserArg `help:"Assign to user (ID, email or @username)"`
 }
 
 func (cmd *CommentsAddListCmd) Run(ctx context.Context) error {
@@ -315,23 +301,33 @@ func (cmd *CommentsAddListCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	listID, err := cmd.ListID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	assignee, err := cmd.Assignee.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	req := clickup.CreateListCommentRequest{
 		CommentText: cmd.Text,
-		Assignee:    cmd.Assignee,
+		Assignee:    assignee,
 	}
 
-	result, err := client.Comments().AddList(ctx, cmd.ListID, req)
+	result, err := client.Comments().AddList(ctx, listID, req)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID"}
 		rows := [][]string{{result.ID.String()}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Comment added to list (ID: %s)\n", result.ID)
@@ -357,43 +353,26 @@ func (cmd *CommentsViewCommentsCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "USER", "TEXT", "DATE"}
-		var rows [][]string
-		for _, comment := range result.Comments {
-			rows = append(rows, []string{comment.ID.String(), comment.User.Username, comment.Text, comment.Date})
-		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
-	}
-
-	if len(result.Comments) == 0 {
-		fmt.Fprintf(os.Stderr, "No comments on view %s\n", cmd.ViewID)
-		return nil
-	}
-
-	fmt.Fprintf(os.Stderr, "Found %d comments on view %s\n\n", len(result.Comments), cmd.ViewID)
 
+	headers := []string{"ID", "USER", "TEXT", "DATE"}
+	var rows [][]string
 	for _, comment := range result.Comments {
-		fmt.Printf("ID: %s\n", comment.ID)
-		fmt.Printf("  User: %s\n", comment.User.Username)
-		fmt.Printf("  Text: %s\n", comment.Text)
-
-		if comment.Date != "" {
-			fmt.Printf("  Date: %s\n", comment.Date)
-		}
+		rows = append(rows, []string{comment.ID.String(), comment.User.Username, comment.Text, outfmt.Timestamp(ctx, comment.Date)})
+	}
 
-		fmt.Println()
+	if !reportRows(ctx, len(rows), "comments on view "+cmd.ViewID) {
+		return nil
 	}
 
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
 type CommentsAddViewCmd struct {
-	ViewID   string `arg:"" required:"" help:"View ID"`
-	Text     string `arg:"" required:"" help:"Comment text"`
-	Assignee int    `help:"Assign to user ID"`
+	ViewID   string  `arg:"" required:"" help:"View ID"`
+	Text     string  `arg:"" required:"" help:"Comment text"`
+	Assignee UserArg `help:"Assign to user (ID, email or @username)"`
 }
 
 func (cmd *CommentsAddViewCmd) Run(ctx context.Context) error {
@@ -402,9 +381,14 @@ func (cmd *CommentsAddViewCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	assignee, err := cmd.Assignee.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	req := clickup.CreateViewCommentRequest{
 		CommentText: cmd.Text,
-		Assignee:    cmd.Assignee,
+		Assignee:    assignee,
 	}
 
 	result, err := client.Comments().AddView(ctx, cmd.ViewID, req)
@@ -413,12 +397,12 @@ func (cmd *CommentsAddViewCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID"}
 		rows := [][]string{{result.ID.String()}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Comment added to view (ID: %s)\n", result.ID)
@@ -442,7 +426,7 @@ func (cmd *CommentsSubtypesCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME"}
@@ -450,7 +434,7 @@ func (cmd *CommentsSubtypesCmd) Run(ctx context.Context) error {
 		for _, subtype := range result.Subtypes {
 			rows = append(rows, []string{subtype.ID, subtype.Name})
 		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	if len(result.Subtypes) == 0 {
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/builtbyrobben/clickup-cli/internal/dateparse"
+	"github.com/builtbyrobben/clickup-cli/internal/outfmt"
+)
+
+// DateFlag is a date-typed flag. It accepts anything dateparse does (ISO
+// 8601, "2026-10-20 17:00", tomorrow, "next friday", eow, +3d, Unix ms) and
+// is resolved in the --tz zone when the command runs.
+type DateFlag string
+
+// Millis resolves the flag to Unix milliseconds; an unset flag is 0. The
+// resolved time is logged with --verbose and printed with --dry-run. name is
+// the flag as typed, e.g. "--due".
+func (d DateFlag) Millis(ctx context.Context, name string) (int64, error) {
+	if d == "" {
+		return 0, nil
+	}
+
+	loc := outfmt.FromContext(ctx).Time.Location
+	if loc == nil {
+		loc = time.Local
+	}
+
+	t, err := dateparse.Parse(string(d), time.Now().In(loc))
+	if err != nil {
+		return 0, newUsageError(fmt.Errorf("%s: %w", name, err))
+	}
+
+	ms := t.UnixMilli()
+
+	slog.Debug("resolved date flag", "flag", name, "input", string(d), "time", t.Format(time.RFC3339), "ms", ms)
+
+	if dryRunEnabled(ctx) {
+		fmt.Fprintf(os.Stderr, "Resolved %s %q to %s (%d)\n", name, string(d), t.Format(time.RFC3339), ms)
+	}
+
+	return ms, nil
+}
@@ -21,7 +21,7 @@ type DocsCmd struct {
 }
 
 type DocsSearchCmd struct {
-	Query string `name:"query" short:"q" help:"Search query"`
+	Search string `name:"search" short:"q" help:"Search query"`
 }
 
 func (cmd *DocsSearchCmd) Run(ctx context.Context) error {
@@ -30,41 +30,30 @@ func (cmd *DocsSearchCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	result, err := client.Docs().Search(ctx, cmd.Query)
+	result, err := client.Docs().Search(ctx, cmd.Search)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "NAME", "CREATED", "CREATOR_ID"}
-		rows := make([][]string, 0, len(result.Docs))
-		for _, d := range result.Docs {
-			creatorID := ""
-			if d.Creator != nil {
-				creatorID = fmt.Sprintf("%d", d.Creator.ID)
-			}
-			rows = append(rows, []string{d.ID, d.Name, fmt.Sprintf("%d", d.DateCreated), creatorID})
+
+	headers := []string{"ID", "NAME", "CREATED", "CREATOR_ID"}
+	rows := make([][]string, 0, len(result.Docs))
+	for _, d := range result.Docs {
+		creatorID := ""
+		if d.Creator != nil {
+			creatorID = fmt.Sprintf("%d", d.Creator.ID)
 		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		rows = append(rows, []string{d.ID, d.Name, outfmt.Timestamp(ctx, d.DateCreated), creatorID})
 	}
 
-	if len(result.Docs) == 0 {
-		fmt.Fprintln(os.Stderr, "No docs found")
+	if !reportRows(ctx, len(rows), "docs") {
 		return nil
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d docs\n\n", len(result.Docs))
-
-	for _, d := range result.Docs {
-		fmt.Printf("ID: %s\n", d.ID)
-		fmt.Printf("  Name: %s\n", d.Name)
-		fmt.Printf("  Created: %d\n\n", d.DateCreated)
-	}
-
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
 type DocsGetCmd struct {
@@ -83,12 +72,12 @@ func (cmd *DocsGetCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	fmt.Printf("ID: %s\n", result.ID)
 	fmt.Printf("Name: %s\n", result.Name)
-	fmt.Printf("Created: %d\n", result.DateCreated)
+	fmt.Printf("Created: %s\n", outfmt.Timestamp(ctx, result.DateCreated))
 
 	return nil
 }
@@ -109,31 +98,20 @@ func (cmd *DocsPageListingCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
-	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"PAGE_ID", "TITLE", "ORDER"}
-		rows := make([][]string, 0, len(result.Pages))
-		for _, p := range result.Pages {
-			rows = append(rows, []string{p.ID, p.Name, fmt.Sprintf("%d", p.Order)})
-		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	if len(result.Pages) == 0 {
-		fmt.Fprintln(os.Stderr, "No pages found")
-		return nil
+	headers := []string{"PAGE_ID", "TITLE", "ORDER"}
+	rows := make([][]string, 0, len(result.Pages))
+	for _, p := range result.Pages {
+		rows = append(rows, []string{p.ID, p.Name, fmt.Sprintf("%d", p.Order)})
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d pages\n\n", len(result.Pages))
-
-	for _, p := range result.Pages {
-		fmt.Printf("ID: %s\n", p.ID)
-		fmt.Printf("  Title: %s\n", p.Name)
-		fmt.Printf("  Order: %d\n\n", p.Order)
+	if !reportRows(ctx, len(rows), "pages") {
+		return nil
 	}
 
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
 type DocsPagesCmd struct {
@@ -152,35 +130,20 @@ func (cmd *DocsPagesCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
-	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"PAGE_ID", "TITLE", "ORDER"}
-		rows := make([][]string, 0, len(result.Pages))
-		for _, p := range result.Pages {
-			rows = append(rows, []string{p.ID, p.Name, fmt.Sprintf("%d", p.Order)})
-		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
-	}
-
-	if len(result.Pages) == 0 {
-		fmt.Fprintln(os.Stderr, "No pages found")
-		return nil
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d pages\n\n", len(result.Pages))
-
+	headers := []string{"PAGE_ID", "TITLE", "ORDER"}
+	rows := make([][]string, 0, len(result.Pages))
 	for _, p := range result.Pages {
-		fmt.Printf("ID: %s\n", p.ID)
-		fmt.Printf("  Title: %s\n", p.Name)
-		fmt.Printf("  Order: %d\n\n", p.Order)
+		rows = append(rows, []string{p.ID, p.Name, fmt.Sprintf("%d", p.Order)})
+	}
 
-		if p.Content != "" {
-			fmt.Printf("  Content:\n%s\n\n", p.Content)
-		}
+	if !reportRows(ctx, len(rows), "pages") {
+		return nil
 	}
 
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
 type DocsPageCmd struct {
@@ -200,7 +163,7 @@ func (cmd *DocsPageCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	fmt.Printf("ID: %s\n", result.ID)
@@ -236,7 +199,7 @@ func (cmd *DocsCreateCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	fmt.Fprintf(os.Stderr, "Created doc: %s\n", result.Name)
@@ -268,7 +231,7 @@ func (cmd *DocsCreatePageCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	fmt.Fprintf(os.Stderr, "Created page: %s\n", result.Name)
@@ -301,7 +264,7 @@ func (cmd *DocsEditPageCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	fmt.Fprintf(os.Stderr, "Updated page: %s\n", result.Name)
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/builtbyrobben/clickup-cli/internal/api"
+	"github.com/builtbyrobben/clickup-cli/internal/outfmt"
+)
+
+func dryRunEnabled(ctx context.Context) bool {
+	rf := getRootFlags(ctx)
+	return rf != nil && rf.DryRun
+}
+
+// printDryRun returns a hook that writes suppressed requests to stdout in the
+// active output mode. Concurrent requests are printed one at a time.
+func printDryRun(ctx context.Context) api.DryRunFunc {
+	var mu sync.Mutex
+
+	return func(req api.DryRunRequest) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if outfmt.IsJSON(ctx) {
+			out := map[string]any{
+				"dry_run": true,
+				"method":  req.Method,
+				"url":     req.URL,
+			}
+			if len(req.Body) > 0 {
+				out["body"] = req.Body
+			}
+			if req.FileName != "" {
+				out["file"] = req.FileName
+			}
+			_ = outfmt.WriteJSON(ctx, os.Stdout, out)
+			return
+		}
+
+		if outfmt.IsPlain(ctx) {
+			var body bytes.Buffer
+			if len(req.Body) > 0 {
+				_ = json.Compact(&body, req.Body)
+			}
+			headers := []string{"DRY_RUN", "METHOD", "URL", "BODY", "FILE"}
+			rows := [][]string{{"true", req.Method, req.URL, body.String(), req.FileName}}
+			_ = outfmt.WritePlain(ctx, os.Stdout, headers, rows)
+			return
+		}
+
+		fmt.Printf("%s %s\n", req.Method, req.URL)
+		if len(req.Body) > 0 {
+			var body bytes.Buffer
+			if json.Indent(&body, req.Body, "", "  ") == nil {
+				fmt.Println(body.String())
+			} else {
+				fmt.Println(string(req.Body))
+			}
+		}
+		if req.FileName != "" {
+			fmt.Printf("File: %s\n", req.FileName)
+		}
+	}
+}
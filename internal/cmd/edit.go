@@ -0,0 +1,301 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/builtbyrobben/clickup-cli/internal/api"
+	"github.com/builtbyrobben/clickup-cli/internal/clickup"
+	"github.com/builtbyrobben/clickup-cli/internal/outfmt"
+	"github.com/builtbyrobben/clickup-cli/internal/taskedit"
+)
+
+// TasksEditCmd opens a task in $EDITOR as YAML front matter over its
+// Markdown description and applies whatever was changed.
+type TasksEditCmd struct {
+	TaskID TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
+}
+
+func (cmd *TasksEditCmd) Run(ctx context.Context) error {
+	client, err := getClickUpClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	params := clickup.GetTaskParams{IncludeMarkdownDescription: true}
+
+	task, err := client.Tasks().Get(ctx, taskID, params)
+	if err != nil {
+		return err
+	}
+
+	loc := outfmt.FromContext(ctx).Time.Location
+	if loc == nil {
+		loc = time.Local
+	}
+
+	before := taskedit.FromTask(task, loc)
+
+	data, err := taskedit.Render(before)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp("", "clickup-task-*.md")
+	if err != nil {
+		return fmt.Errorf("create edit file: %w", err)
+	}
+
+	path := f.Name()
+	_, writeErr := f.Write(data)
+
+	if err := errors.Join(writeErr, f.Close()); err != nil {
+		_ = os.Remove(path)
+		return fmt.Errorf("write edit file: %w", err)
+	}
+
+	// The file is kept when anything goes wrong, so that the edits are not
+	// lost.
+	keep := true
+	defer func() {
+		if !keep {
+			_ = os.Remove(path)
+		}
+	}()
+
+	if err := runEditor(path); err != nil {
+		return err
+	}
+
+	edited, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read edit file: %w", err)
+	}
+
+	after, err := taskedit.Parse(edited)
+	if err != nil {
+		return newUsageError(fmt.Errorf("%s: %w", path, err))
+	}
+
+	now := time.Now().In(loc)
+
+	diff, err := taskedit.Compare(before, after, now)
+	if err != nil {
+		return newUsageError(fmt.Errorf("%s: %w", path, err))
+	}
+
+	if diff.Empty() {
+		keep = false
+
+		fmt.Fprintln(os.Stderr, "No changes")
+
+		return nil
+	}
+
+	fields := make([]clickup.CustomField, 0, len(task.CustomFields))
+	for _, f := range task.CustomFields {
+		fields = append(fields, clickup.CustomField{ID: f.ID, Name: f.Name, Type: f.Type, TypeConfig: f.TypeConfig})
+	}
+
+	if err := diff.ResolveDates(fields, now); err != nil {
+		return newUsageError(fmt.Errorf("%s: %w", path, err))
+	}
+
+	values, err := clickup.CustomFieldValues(fields, diff.SetFields)
+	if err != nil {
+		return newUsageError(fmt.Errorf("%s: %w", path, err))
+	}
+
+	add, err := resolveUsers(ctx, client, toUserArgs(diff.AddAssignees))
+	if err != nil {
+		return err
+	}
+
+	// Someone else may have changed the task while the editor was open.
+	current, err := client.Tasks().Get(ctx, taskID, params)
+	if err != nil {
+		return err
+	}
+
+	if current.DateUpdated != task.DateUpdated && !forceEnabled(ctx) {
+		return fmt.Errorf("task %s was changed in ClickUp while you were editing; your version is in %s (use --force to overwrite)", taskID, path)
+	}
+
+	if err := applyTaskEdit(ctx, client, task, diff, add, values); err != nil {
+		return fmt.Errorf("%w; your version is in %s", err, path)
+	}
+
+	keep = false
+
+	if dryRunEnabled(ctx) {
+		return api.ErrDryRun
+	}
+
+	changed := diff.Changed()
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]any{"task_id": taskID, "changed": changed})
+	}
+
+	if outfmt.IsPlain(ctx) {
+		rows := make([][]string, 0, len(changed))
+		for _, name := range changed {
+			rows = append(rows, []string{taskID, name})
+		}
+
+		return outfmt.WritePlain(ctx, os.Stdout, []string{"TASK_ID", "CHANGED"}, rows)
+	}
+
+	fmt.Fprintf(os.Stderr, "Updated task %s: %s\n", taskID, strings.Join(changed, ", "))
+
+	return nil
+}
+
+// applyTaskEdit sends the changes in diff: the core fields in one update,
+// then each tag and custom field on its own. A dry run goes on past each
+// suppressed request so that all of them are printed.
+func applyTaskEdit(ctx context.Context, client *clickup.Client, task *clickup.Task, diff taskedit.Diff, add []int, values []clickup.CustomFieldValue) error {
+	var req clickup.UpdateTaskRequest
+
+	send := false
+
+	if diff.Name != nil {
+		req.Name = *diff.Name
+		send = true
+	}
+
+	if diff.Status != nil {
+		req.Status = *diff.Status
+		send = true
+	}
+
+	if diff.Priority != nil {
+		req.Priority = diff.Priority
+		req.ClearPriority = *diff.Priority == 0
+		send = true
+	}
+
+	if diff.Due != nil {
+		req.DueDate = clickup.ClearableMillis{Millis: *diff.Due, Clear: *diff.Due == 0}
+		req.DueDateTime = diff.DueTime
+		send = true
+	}
+
+	if diff.Description != nil {
+		// The API ignores an empty description; a single space clears it.
+		req.MarkdownDescription = *diff.Description
+		if req.MarkdownDescription == "" {
+			req.Description = " "
+		}
+
+		send = true
+	}
+
+	if rem := editedAssigneeIDs(task.Assignees, diff.RemoveAssignees); len(add) > 0 || len(rem) > 0 {
+		req.Assignees = &clickup.TaskAssigneesUpdate{Add: add, Rem: rem}
+		send = true
+	}
+
+	if send {
+		if _, err := client.Tasks().Update(ctx, task.ID, req); err != nil && !errors.Is(err, api.ErrDryRun) {
+			return err
+		}
+	}
+
+	for _, tag := range diff.AddTags {
+		if err := client.Tags().AddToTask(ctx, task.ID, tag); err != nil && !errors.Is(err, api.ErrDryRun) {
+			return fmt.Errorf("add tag %q: %w", tag, err)
+		}
+	}
+
+	for _, tag := range diff.RemoveTags {
+		if err := client.Tags().RemoveFromTask(ctx, task.ID, tag); err != nil && !errors.Is(err, api.ErrDryRun) {
+			return fmt.Errorf("remove tag %q: %w", tag, err)
+		}
+	}
+
+	for _, value := range values {
+		if err := client.CustomFields().Set(ctx, task.ID, value.ID, value.Value); err != nil && !errors.Is(err, api.ErrDryRun) {
+			return fmt.Errorf("set custom field %s: %w", value.ID, err)
+		}
+	}
+
+	for _, name := range diff.ClearFields {
+		for _, f := range task.CustomFields {
+			if f.Name != name {
+				continue
+			}
+
+			if err := client.CustomFields().Remove(ctx, task.ID, f.ID); err != nil && !errors.Is(err, api.ErrDryRun) {
+				return fmt.Errorf("clear custom field %q: %w", name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// editedAssigneeIDs maps the emails or usernames of removed assignees back to
+// the IDs of the task's assignees.
+func editedAssigneeIDs(assignees []clickup.User, refs []string) []int {
+	var ids []int
+
+	for _, ref := range refs {
+		for _, u := range assignees {
+			if strings.EqualFold(u.Email, ref) || strings.EqualFold(u.Username, ref) {
+				ids = append(ids, u.ID)
+				break
+			}
+		}
+	}
+
+	return ids
+}
+
+func toUserArgs(refs []string) []UserArg {
+	args := make([]UserArg, 0, len(refs))
+	for _, ref := range refs {
+		args = append(args, UserArg(ref))
+	}
+
+	return args
+}
+
+// runEditor opens path in the editor and waits for it to exit.
+func runEditor(path string) error {
+	args := editorArgs()
+
+	c := exec.Command(args[0], append(args[1:], path)...)
+	c.Stdin = os.Stdin
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+
+	if err := c.Run(); err != nil {
+		return fmt.Errorf("editor %s: %w; your version is in %s", args[0], err, path)
+	}
+
+	return nil
+}
+
+// editorArgs returns the command line of $VISUAL, else $EDITOR, else vi.
+// Blank variables are skipped. The editor may carry arguments, as in
+// EDITOR="code --wait".
+func editorArgs() []string {
+	for _, name := range []string{"VISUAL", "EDITOR"} {
+		if args := strings.Fields(os.Getenv(name)); len(args) > 0 {
+			return args
+		}
+	}
+
+	return []string{"vi"}
+}
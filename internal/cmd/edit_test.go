@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestEditorArgs(t *testing.T) {
+	for _, tt := range []struct {
+		visual, editor string
+		want           []string
+	}{
+		{"", "", []string{"vi"}},
+		{"  ", "\t", []string{"vi"}},
+		{" ", "code --wait", []string{"code", "--wait"}},
+		{"nano", "code --wait", []string{"nano"}},
+	} {
+		t.Setenv("VISUAL", tt.visual)
+		t.Setenv("EDITOR", tt.editor)
+
+		if got := editorArgs(); !slices.Equal(got, tt.want) {
+			t.Errorf("VISUAL=%q EDITOR=%q: expected %v, got %v", tt.visual, tt.editor, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,585 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/builtbyrobben/clickup-cli/internal/clickup"
+	"github.com/builtbyrobben/clickup-cli/internal/outfmt"
+)
+
+type ExportCmd struct {
+	Tasks ExportTasksCmd `cmd:"" help:"Export every task of a list, folder or space"`
+}
+
+// ExportTasksCmd snapshots the tasks of a list, folder or space.
+type ExportTasksCmd struct {
+	List    ListArg   `xor:"scope" required:"" help:"List (ID, name, path or URL) to export"`
+	Folder  FolderArg `xor:"scope" required:"" help:"Folder (ID, name, path or URL) to export"`
+	Space   SpaceArg  `xor:"scope" required:"" help:"Space (ID, name or URL) to export"`
+	Format  string    `name:"format" short:"f" enum:",csv,json,markdown" default:"" help:"csv (one flattened row per task), json (nested) or markdown (grouped by status); default: json with --json, csv with --output csv, otherwise markdown"`
+	Include []string  `enum:"comments,checklists,time,fields" sep:"," help:"Also export comments, checklists, time entries and custom field values (comma-separated)" placeholder:"comments,checklists,time,fields"`
+	Out     string    `help:"Write the export to this file instead of stdout" type:"path"`
+}
+
+// exportDoc is the nested JSON form of an export.
+type exportDoc struct {
+	Scope      string        `json:"scope"`
+	ID         string        `json:"id"`
+	Name       string        `json:"name"`
+	ExportedAt string        `json:"exported_at"`
+	Lists      []*exportList `json:"lists"`
+}
+
+type exportList struct {
+	ID     string                `json:"id"`
+	Name   string                `json:"name"`
+	Fields []clickup.CustomField `json:"-"`
+	Tasks  []*exportTask         `json:"tasks"`
+}
+
+// exportTask is a task with its extras and its subtasks nested below it.
+type exportTask struct {
+	clickup.Task
+
+	Comments    []clickup.Comment            `json:"comments,omitempty"`
+	TimeEntries []clickup.LegacyTimeInterval `json:"time_entries,omitempty"`
+	Subtasks    []*exportTask                `json:"subtasks,omitempty"`
+}
+
+func (cmd *ExportTasksCmd) includes(what string) bool {
+	return slices.Contains(cmd.Include, what)
+}
+
+func (cmd *ExportTasksCmd) format(ctx context.Context) string {
+	if cmd.Format != "" {
+		return cmd.Format
+	}
+
+	switch {
+	case outfmt.IsJSON(ctx):
+		return "json"
+	case outfmt.FromContext(ctx).Format == outfmt.FormatCSV:
+		return "csv"
+	default:
+		return "markdown"
+	}
+}
+
+func (cmd *ExportTasksCmd) Run(ctx context.Context) error {
+	client, err := getClickUpClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	doc, err := cmd.scope(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	doc.ExportedAt = time.Now().UTC().Format(time.RFC3339)
+
+	for _, list := range doc.Lists {
+		if err := cmd.fill(ctx, client, list); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Out == "" {
+		return cmd.write(ctx, os.Stdout, doc)
+	}
+
+	f, err := os.Create(cmd.Out)
+	if err != nil {
+		return fmt.Errorf("create export file: %w", err)
+	}
+
+	err = cmd.write(ctx, f, doc)
+
+	// The data may only reach the disk on close.
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return fmt.Errorf("write export file: %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "Exported %d tasks to %s\n", doc.count(), cmd.Out)
+
+	return nil
+}
+
+// write writes the export in the chosen format.
+func (cmd *ExportTasksCmd) write(ctx context.Context, w io.Writer, doc *exportDoc) error {
+	switch cmd.format(ctx) {
+	case "json":
+		return outfmt.WriteJSON(ctx, w, doc)
+	case "csv":
+		return cmd.writeCSV(ctx, w, doc)
+	default:
+		return cmd.writeMarkdown(ctx, w, doc)
+	}
+}
+
+// scope resolves the lists to export and names the export after the list,
+// folder or space.
+func (cmd *ExportTasksCmd) scope(ctx context.Context, client *clickup.Client) (*exportDoc, error) {
+	switch {
+	case cmd.List != "":
+		listID, err := cmd.List.Resolve(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+
+		list, err := client.Lists().Get(ctx, listID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &exportDoc{Scope: "list", ID: list.ID, Name: list.Name, Lists: []*exportList{{ID: list.ID, Name: list.Name}}}, nil
+	case cmd.Folder != "":
+		folderID, err := cmd.Folder.Resolve(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+
+		folder, err := client.Folders().Get(ctx, folderID)
+		if err != nil {
+			return nil, err
+		}
+
+		lists, err := client.Lists().ListByFolder(ctx, folderID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &exportDoc{Scope: "folder", ID: folder.ID, Name: folder.Name, Lists: exportLists(lists.Lists)}, nil
+	default:
+		spaceID, err := cmd.Space.Resolve(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+
+		space, err := client.Spaces().Get(ctx, spaceID)
+		if err != nil {
+			return nil, err
+		}
+
+		doc := &exportDoc{Scope: "space", ID: space.ID, Name: space.Name}
+
+		folders, err := client.Lists().ListFolders(ctx, spaceID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, folder := range folders.Folders {
+			lists, err := client.Lists().ListByFolder(ctx, folder.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, list := range exportLists(lists.Lists) {
+				list.Name = folder.Name + "/" + list.Name
+				doc.Lists = append(doc.Lists, list)
+			}
+		}
+
+		folderless, err := client.Lists().ListFolderless(ctx, spaceID)
+		if err != nil {
+			return nil, err
+		}
+
+		doc.Lists = append(doc.Lists, exportLists(folderless.Lists)...)
+
+		return doc, nil
+	}
+}
+
+func exportLists(lists []clickup.List) []*exportList {
+	out := make([]*exportList, 0, len(lists))
+	for _, list := range lists {
+		out = append(out, &exportList{ID: list.ID, Name: list.Name})
+	}
+
+	return out
+}
+
+// fill pages through every task of the list, closed tasks and subtasks
+// included, adds the requested extras and nests subtasks under their parents.
+// Time entries come from the task's own time endpoint, which covers every
+// user and date; the workspace endpoint only returns the caller's recent ones.
+func (cmd *ExportTasksCmd) fill(ctx context.Context, client *clickup.Client, list *exportList) error {
+	if cmd.includes("fields") {
+		fields, err := client.CustomFields().ListByList(ctx, list.ID)
+		if err != nil {
+			return err
+		}
+
+		list.Fields = fields.Fields
+	}
+
+	var all []*exportTask
+
+	for task, err := range client.Tasks().IterAll(ctx, list.ID) {
+		if err != nil {
+			return err
+		}
+
+		if !cmd.includes("checklists") {
+			task.Checklists = nil
+		}
+
+		if !cmd.includes("fields") {
+			task.CustomFields = nil
+		}
+
+		task.Subtasks = nil
+		all = append(all, &exportTask{Task: task})
+	}
+
+	for _, task := range all {
+		if cmd.includes("comments") {
+			for comment, err := range client.Comments().IterAll(ctx, task.ID) {
+				if err != nil {
+					return err
+				}
+
+				task.Comments = append(task.Comments, comment)
+			}
+		}
+
+		if cmd.includes("time") {
+			entries, err := client.LegacyTime().List(ctx, task.ID, false, "")
+			if err != nil {
+				return err
+			}
+
+			task.TimeEntries = entries.Data
+		}
+	}
+
+	byID := make(map[string]*exportTask, len(all))
+	for _, task := range all {
+		byID[task.ID] = task
+	}
+
+	for _, task := range all {
+		if parent, ok := byID[task.Parent]; ok && parent != task {
+			parent.Subtasks = append(parent.Subtasks, task)
+		} else {
+			list.Tasks = append(list.Tasks, task)
+		}
+	}
+
+	return nil
+}
+
+func (doc *exportDoc) count() int {
+	n := 0
+
+	for _, list := range doc.Lists {
+		walkExport(list.Tasks, 0, func(*exportTask, int) { n++ })
+	}
+
+	return n
+}
+
+// walkExport visits tasks depth first, parents before their subtasks.
+func walkExport(tasks []*exportTask, depth int, fn func(task *exportTask, depth int)) {
+	for _, task := range tasks {
+		fn(task, depth)
+		walkExport(task.Subtasks, depth+1, fn)
+	}
+}
+
+// writeCSV writes one row per task with subtasks after their parents. Custom
+// fields get a column each, named after the field.
+func (cmd *ExportTasksCmd) writeCSV(ctx context.Context, w io.Writer, doc *exportDoc) error {
+	var fieldNames []string
+
+	for _, list := range doc.Lists {
+		for _, f := range list.Fields {
+			if !slices.Contains(fieldNames, f.Name) {
+				fieldNames = append(fieldNames, f.Name)
+			}
+		}
+	}
+
+	headers := []string{
+		"list", "id", "custom_id", "parent", "name", "status", "priority", "assignees", "tags",
+		"due_date", "start_date", "date_created", "date_updated", "date_closed",
+		"time_estimate", "time_spent", "url",
+	}
+
+	if cmd.includes("checklists") {
+		headers = append(headers, "checklists")
+	}
+
+	if cmd.includes("comments") {
+		headers = append(headers, "comments")
+	}
+
+	if cmd.includes("time") {
+		headers = append(headers, "time_tracked")
+	}
+
+	for _, name := range fieldNames {
+		headers = append(headers, "field:"+name)
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(headers); err != nil {
+		return err
+	}
+
+	for _, list := range doc.Lists {
+		var err error
+
+		walkExport(list.Tasks, 0, func(task *exportTask, _ int) {
+			if err != nil {
+				return
+			}
+
+			err = cw.Write(cmd.csvRow(ctx, list, task, fieldNames))
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+func (cmd *ExportTasksCmd) csvRow(ctx context.Context, list *exportList, task *exportTask, fieldNames []string) []string {
+	priority := ""
+	if task.Priority != nil {
+		priority = task.Priority.Name
+	}
+
+	tags := make([]string, 0, len(task.Tags))
+	for _, tag := range task.Tags {
+		tags = append(tags, tag.Name)
+	}
+
+	row := []string{
+		list.Name, task.ID, task.CustomID, task.Parent, task.Name, task.Status.Status, priority,
+		usernames(task.Assignees), strings.Join(tags, ", "),
+		exportTime(ctx, task.DueDate), exportTime(ctx, task.StartDate), exportTime(ctx, task.DateCreated),
+		exportTime(ctx, task.DateUpdated), exportTime(ctx, task.DateClosed),
+		exportDuration(task.TimeEstimate), exportDuration(task.TimeSpent), task.URL,
+	}
+
+	if cmd.includes("checklists") {
+		lists := make([]string, 0, len(task.Checklists))
+		for _, c := range task.Checklists {
+			lists = append(lists, checklistSummary(c))
+		}
+
+		row = append(row, strings.Join(lists, "; "))
+	}
+
+	if cmd.includes("comments") {
+		comments := make([]string, 0, len(task.Comments))
+		for _, c := range task.Comments {
+			comments = append(comments, c.User.Username+": "+strings.TrimSpace(c.Text))
+		}
+
+		row = append(row, strings.Join(comments, "\n"))
+	}
+
+	if cmd.includes("time") {
+		row = append(row, exportDuration(trackedMillis(task.TimeEntries)))
+	}
+
+	for _, name := range fieldNames {
+		row = append(row, fieldText(ctx, task.CustomFields, name))
+	}
+
+	return row
+}
+
+// writeMarkdown writes a document with a section per list and the tasks of
+// each list grouped by status, in the order the statuses first appear.
+func (cmd *ExportTasksCmd) writeMarkdown(ctx context.Context, w io.Writer, doc *exportDoc) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s\n\n", doc.Name)
+	fmt.Fprintf(&b, "Exported %s, %d tasks.\n", exportTime(ctx, doc.ExportedAt), doc.count())
+
+	for _, list := range doc.Lists {
+		fmt.Fprintf(&b, "\n## %s\n", list.Name)
+
+		if len(list.Tasks) == 0 {
+			b.WriteString("\nNo tasks.\n")
+			continue
+		}
+
+		var statuses []string
+
+		groups := map[string][]*exportTask{}
+
+		for _, task := range list.Tasks {
+			status := task.Status.Status
+			if _, ok := groups[status]; !ok {
+				statuses = append(statuses, status)
+			}
+
+			groups[status] = append(groups[status], task)
+		}
+
+		for _, status := range statuses {
+			fmt.Fprintf(&b, "\n### %s (%d)\n\n", status, len(groups[status]))
+
+			walkExport(groups[status], 0, func(task *exportTask, depth int) {
+				cmd.writeMarkdownTask(ctx, &b, task, strings.Repeat("  ", depth))
+			})
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+func (cmd *ExportTasksCmd) writeMarkdownTask(ctx context.Context, b *strings.Builder, task *exportTask, indent string) {
+	mark := " "
+	if task.IsDone() {
+		mark = "x"
+	}
+
+	details := []string{"`" + task.ID + "`"}
+	if task.Status.Status != "" && indent != "" {
+		details = append(details, task.Status.Status)
+	}
+
+	if task.Priority != nil {
+		details = append(details, task.Priority.Name)
+	}
+
+	if len(task.Assignees) > 0 {
+		details = append(details, usernames(task.Assignees))
+	}
+
+	if task.DueDate != "" {
+		details = append(details, "due "+outfmt.Timestamp(ctx, task.DueDate))
+	}
+
+	fmt.Fprintf(b, "%s- [%s] **%s** (%s)\n", indent, mark, markdownEscape(task.Name), strings.Join(details, ", "))
+
+	for _, c := range task.Checklists {
+		fmt.Fprintf(b, "%s  - Checklist %s\n", indent, markdownEscape(checklistSummary(c)))
+	}
+
+	for _, f := range task.CustomFields {
+		if value := customFieldText(ctx, f); value != "" {
+			fmt.Fprintf(b, "%s  - %s: %s\n", indent, markdownEscape(f.Name), markdownEscape(value))
+		}
+	}
+
+	if len(task.TimeEntries) > 0 {
+		fmt.Fprintf(b, "%s  - Time tracked: %s\n", indent, formatDuration(trackedMillis(task.TimeEntries)))
+	}
+
+	for _, c := range task.Comments {
+		text := strings.Join(strings.Fields(c.Text), " ")
+		fmt.Fprintf(b, "%s  - Comment by %s: %s\n", indent, c.User.Username, markdownEscape(text))
+	}
+}
+
+func checklistSummary(c clickup.Checklist) string {
+	resolved := 0
+	items := make([]string, 0, len(c.Items))
+
+	for _, item := range c.Items {
+		mark := "[ ]"
+		if item.Resolved {
+			mark = "[x]"
+			resolved++
+		}
+
+		items = append(items, mark+" "+item.Name)
+	}
+
+	return fmt.Sprintf("%s (%d/%d): %s", c.Name, resolved, len(c.Items), strings.Join(items, ", "))
+}
+
+// fieldText renders the value of the named custom field.
+func fieldText(ctx context.Context, fields []clickup.TaskCustomField, name string) string {
+	for _, f := range fields {
+		if f.Name == name {
+			return customFieldText(ctx, f)
+		}
+	}
+
+	return ""
+}
+
+// customFieldText renders a custom field's value, formatting dates.
+func customFieldText(ctx context.Context, f clickup.TaskCustomField) string {
+	value := f.Text()
+	if f.Type == "date" && value != "" {
+		value = outfmt.Timestamp(ctx, value)
+	}
+
+	return value
+}
+
+func trackedMillis(entries []clickup.LegacyTimeInterval) int64 {
+	var total int64
+
+	for _, e := range entries {
+		if e.Time > 0 {
+			total += e.Time
+		}
+	}
+
+	return total
+}
+
+// exportTime renders a millisecond timestamp (or an RFC 3339 time) as ISO
+// 8601 in the --tz zone, so exports sort and parse reliably.
+func exportTime(ctx context.Context, v string) string {
+	if v == "" {
+		return ""
+	}
+
+	loc := outfmt.FromContext(ctx).Time.Location
+	if loc == nil {
+		loc = time.Local
+	}
+
+	if ms, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.UnixMilli(ms).In(loc).Format(time.RFC3339)
+	}
+
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t.In(loc).Format(time.RFC3339)
+	}
+
+	return v
+}
+
+func exportDuration(ms int64) string {
+	if ms <= 0 {
+		return ""
+	}
+
+	return formatDuration(ms)
+}
+
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`)
+
+func markdownEscape(s string) string {
+	return markdownEscaper.Replace(s)
+}
@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/builtbyrobben/clickup-cli/internal/api"
+	"github.com/builtbyrobben/clickup-cli/internal/clickup"
+	"github.com/builtbyrobben/clickup-cli/internal/outfmt"
+)
+
+// exportServer serves list L1: task p1 with subtask s1 (both open) and a
+// closed task d1, one "Points" custom field, two pages of comments on p1 and
+// a time interval on s1.
+func exportServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	responses := map[string]string{
+		"/v2/list/L1/task?include_closed=true&page=0&subtasks=true": `{"last_page":true,"tasks":[
+			{"id":"p1","name":"Parent","status":{"status":"open"},"custom_fields":[{"id":"f1","name":"Points","type":"number","value":5}]},
+			{"id":"s1","name":"Child","parent":"p1","status":{"status":"open"}},
+			{"id":"d1","name":"Shipped","status":{"status":"done","type":"closed"}}
+		]}`,
+		"/v2/list/L1/field":                          `{"fields":[{"id":"f1","name":"Points","type":"number"}]}`,
+		"/v2/task/p1/comment":                        `{"comments":[{"id":"902","comment_text":"second","user":{"username":"ann"},"date":"200"}]}`,
+		"/v2/task/p1/comment?start=200&start_id=902": `{"comments":[{"id":"901","comment_text":"first","user":{"username":"bob"},"date":"100"}]}`,
+		"/v2/task/p1/comment?start=100&start_id=901": `{"comments":[]}`,
+		"/v2/task/s1/comment":                        `{"comments":[]}`,
+		"/v2/task/d1/comment":                        `{"comments":[]}`,
+		"/v2/task/p1/time":                           `{"data":[]}`,
+		"/v2/task/s1/time":                           `{"data":[{"id":"i1","start":0,"end":5400000,"time":5400000}]}`,
+		"/v2/task/d1/time":                           `{"data":[]}`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.RequestURI()]
+		if !ok {
+			t.Errorf("unexpected request %s", r.URL.RequestURI())
+			http.NotFound(w, r)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func exportFixture(t *testing.T, include ...string) (context.Context, *ExportTasksCmd, *exportDoc) {
+	t.Helper()
+
+	server := exportServer(t)
+	client := clickup.NewClient("key", clickup.WithAPIOptions(api.WithBaseURL(server.URL)))
+	ctx := outfmt.WithMode(context.Background(), outfmt.Mode{Time: outfmt.TimeStyle{Location: time.UTC}})
+
+	cmd := &ExportTasksCmd{Include: include}
+	doc := &exportDoc{Scope: "list", ID: "L1", Name: "Sprint", Lists: []*exportList{{ID: "L1", Name: "Sprint"}}}
+
+	if err := cmd.fill(ctx, client, doc.Lists[0]); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return ctx, cmd, doc
+}
+
+func TestExportFill_NestsSubtasksAndAddsExtras(t *testing.T) {
+	t.Parallel()
+
+	_, _, doc := exportFixture(t, "comments", "time")
+
+	tasks := doc.Lists[0].Tasks
+	if len(tasks) != 2 || tasks[0].ID != "p1" || tasks[1].ID != "d1" {
+		t.Fatalf("expected top-level tasks p1 and d1, got %d", len(tasks))
+	}
+
+	if len(tasks[0].Subtasks) != 1 || tasks[0].Subtasks[0].ID != "s1" {
+		t.Fatalf("expected s1 nested under p1, got %+v", tasks[0].Subtasks)
+	}
+
+	var comments []string
+	for _, c := range tasks[0].Comments {
+		comments = append(comments, c.Text)
+	}
+
+	if !slices.Equal(comments, []string{"second", "first"}) {
+		t.Fatalf("expected every page of comments, got %v", comments)
+	}
+
+	if got := trackedMillis(tasks[0].Subtasks[0].TimeEntries); got != 5400000 {
+		t.Fatalf("expected 1h30m tracked on s1, got %d ms", got)
+	}
+
+	if tasks[0].CustomFields != nil {
+		t.Fatalf("expected custom fields to be dropped without --include fields")
+	}
+
+	if doc.count() != 3 {
+		t.Fatalf("expected 3 tasks, got %d", doc.count())
+	}
+}
+
+func TestExportCSV_ColumnsAndCustomFields(t *testing.T) {
+	t.Parallel()
+
+	ctx, cmd, doc := exportFixture(t, "fields", "comments")
+
+	var buf bytes.Buffer
+	if err := cmd.writeCSV(ctx, &buf, doc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("parse CSV: %v", err)
+	}
+
+	headers := records[0]
+	if !slices.Equal(headers[:5], []string{"list", "id", "custom_id", "parent", "name"}) ||
+		!slices.Equal(headers[len(headers)-2:], []string{"comments", "field:Points"}) {
+		t.Fatalf("unexpected headers: %v", headers)
+	}
+
+	column := func(row []string, name string) string {
+		return row[slices.Index(headers, name)]
+	}
+
+	// Subtasks follow their parent.
+	var ids []string
+	for _, row := range records[1:] {
+		ids = append(ids, column(row, "id"))
+	}
+
+	if !slices.Equal(ids, []string{"p1", "s1", "d1"}) {
+		t.Fatalf("unexpected row order: %v", ids)
+	}
+
+	parent, child := records[1], records[2]
+
+	if column(parent, "field:Points") != "5" || column(child, "field:Points") != "" {
+		t.Fatalf("unexpected custom field cells: %q, %q", column(parent, "field:Points"), column(child, "field:Points"))
+	}
+
+	if column(child, "parent") != "p1" || column(parent, "comments") != "ann: second\nbob: first" {
+		t.Fatalf("unexpected rows: %v / %v", parent, child)
+	}
+}
+
+func TestExportMarkdown_GroupsByStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx, cmd, doc := exportFixture(t, "fields")
+
+	var buf bytes.Buffer
+	if err := cmd.writeMarkdown(ctx, &buf, doc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := buf.String()
+
+	for _, want := range []string{
+		"# Sprint\n",
+		"## Sprint\n",
+		"### open (1)\n\n- [ ] **Parent** (`p1`)\n  - Points: 5\n  - [ ] **Child** (`s1`, open)\n",
+		"### done (1)\n\n- [x] **Shipped** (`d1`)\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in:\n%s", want, out)
+		}
+	}
+
+	if strings.Index(out, "### open") > strings.Index(out, "### done") {
+		t.Fatalf("expected statuses in order of first appearance:\n%s", out)
+	}
+}
@@ -17,10 +17,10 @@ type FieldsCmd struct {
 }
 
 type FieldsListCmd struct {
-	ListID   string `help:"List ID"`
-	FolderID string `help:"Folder ID"`
-	SpaceID  string `help:"Space ID"`
-	TeamID   string `help:"Team/Workspace ID"`
+	ListID   ListArg   `help:"List (ID, name, path or URL)"`
+	FolderID FolderArg `help:"Folder (ID, name, path or URL)"`
+	SpaceID  SpaceArg  `help:"Space (ID, name or URL)"`
+	TeamID   string    `help:"Team/Workspace ID"`
 }
 
 func (cmd *FieldsListCmd) Run(ctx context.Context) error {
@@ -29,17 +29,32 @@ func (cmd *FieldsListCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	listID, err := cmd.ListID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	folderID, err := cmd.FolderID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	spaceID, err := cmd.SpaceID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	// Exactly one scope is required
 	count := 0
-	if cmd.ListID != "" {
+	if listID != "" {
 		count++
 	}
 
-	if cmd.FolderID != "" {
+	if folderID != "" {
 		count++
 	}
 
-	if cmd.SpaceID != "" {
+	if spaceID != "" {
 		count++
 	}
 
@@ -54,12 +69,12 @@ func (cmd *FieldsListCmd) Run(ctx context.Context) error {
 	var result *clickup.CustomFieldsResponse
 
 	switch {
-	case cmd.ListID != "":
-		result, err = client.CustomFields().ListByList(ctx, cmd.ListID)
-	case cmd.FolderID != "":
-		result, err = client.CustomFields().ListByFolder(ctx, cmd.FolderID)
-	case cmd.SpaceID != "":
-		result, err = client.CustomFields().ListBySpace(ctx, cmd.SpaceID)
+	case listID != "":
+		result, err = client.CustomFields().ListByList(ctx, listID)
+	case folderID != "":
+		result, err = client.CustomFields().ListByFolder(ctx, folderID)
+	case spaceID != "":
+		result, err = client.CustomFields().ListBySpace(ctx, spaceID)
 	case cmd.TeamID != "":
 		result, err = client.CustomFields().ListByTeam(ctx, cmd.TeamID)
 	}
@@ -69,7 +84,7 @@ func (cmd *FieldsListCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
@@ -84,7 +99,7 @@ func (cmd *FieldsListCmd) Run(ctx context.Context) error {
 			})
 		}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	if len(result.Fields) == 0 {
@@ -107,9 +122,9 @@ func (cmd *FieldsListCmd) Run(ctx context.Context) error {
 }
 
 type FieldsSetCmd struct {
-	TaskID  string `arg:"" required:"" help:"Task ID"`
-	FieldID string `required:"" help:"Custom field ID"`
-	Value   string `arg:"" required:"" help:"Field value (format depends on field type)"`
+	TaskID  TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
+	FieldID string  `required:"" help:"Custom field ID"`
+	Value   string  `arg:"" required:"" help:"Field value (format depends on field type)"`
 }
 
 func (cmd *FieldsSetCmd) Run(ctx context.Context) error {
@@ -118,36 +133,41 @@ func (cmd *FieldsSetCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	// Pass the value as-is (string). For complex types (array, object),
 	// the user would need to pass JSON, which we don't parse here.
 	// This simple approach covers most common field types.
-	if err := client.CustomFields().Set(ctx, cmd.TaskID, cmd.FieldID, cmd.Value); err != nil {
+	if err := client.CustomFields().Set(ctx, taskID, cmd.FieldID, cmd.Value); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":   "success",
-			"task_id":  cmd.TaskID,
+			"task_id":  taskID,
 			"field_id": cmd.FieldID,
 		})
 	}
 
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "TASK_ID", "FIELD_ID"}
-		rows := [][]string{{"success", cmd.TaskID, cmd.FieldID}}
+		rows := [][]string{{"success", taskID, cmd.FieldID}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
-	fmt.Fprintf(os.Stderr, "Custom field %s set on task %s\n", cmd.FieldID, cmd.TaskID)
+	fmt.Fprintf(os.Stderr, "Custom field %s set on task %s\n", cmd.FieldID, taskID)
 
 	return nil
 }
 
 type FieldsRemoveCmd struct {
-	TaskID  string `arg:"" required:"" help:"Task ID"`
-	FieldID string `required:"" help:"Custom field ID"`
+	TaskID  TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
+	FieldID string  `required:"" help:"Custom field ID"`
 }
 
 func (cmd *FieldsRemoveCmd) Run(ctx context.Context) error {
@@ -156,27 +176,32 @@ func (cmd *FieldsRemoveCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	if err := client.CustomFields().Remove(ctx, cmd.TaskID, cmd.FieldID); err != nil {
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if err := client.CustomFields().Remove(ctx, taskID, cmd.FieldID); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":   "success",
 			"message":  "Custom field value removed",
-			"task_id":  cmd.TaskID,
+			"task_id":  taskID,
 			"field_id": cmd.FieldID,
 		})
 	}
 
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "TASK_ID", "FIELD_ID"}
-		rows := [][]string{{"success", cmd.TaskID, cmd.FieldID}}
+		rows := [][]string{{"success", taskID, cmd.FieldID}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
-	fmt.Fprintf(os.Stderr, "Custom field %s removed from task %s\n", cmd.FieldID, cmd.TaskID)
+	fmt.Fprintf(os.Stderr, "Custom field %s removed from task %s\n", cmd.FieldID, taskID)
 
 	return nil
 }
@@ -18,7 +18,7 @@ type FoldersCmd struct {
 }
 
 type FoldersGetCmd struct {
-	FolderID string `arg:"" required:"" help:"Folder ID"`
+	FolderID FolderArg `arg:"" required:"" help:"Folder (ID, name, path or URL)"`
 }
 
 func (cmd *FoldersGetCmd) Run(ctx context.Context) error {
@@ -27,19 +27,24 @@ func (cmd *FoldersGetCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	result, err := client.Folders().Get(ctx, cmd.FolderID)
+	folderID, err := cmd.FolderID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	result, err := client.Folders().Get(ctx, folderID)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME", "TASK_COUNT", "LIST_COUNT"}
 		listCount := fmt.Sprintf("%d", len(result.Lists))
 		rows := [][]string{{result.ID, result.Name, result.TaskCount, listCount}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Folder Details\n\n")
@@ -59,8 +64,8 @@ func (cmd *FoldersGetCmd) Run(ctx context.Context) error {
 }
 
 type FoldersCreateCmd struct {
-	SpaceID string `arg:"" required:"" help:"Space ID"`
-	Name    string `arg:"" required:"" help:"Folder name"`
+	SpaceID SpaceArg `arg:"" required:"" help:"Space (ID, name or URL)"`
+	Name    string   `arg:"" required:"" help:"Folder name"`
 }
 
 func (cmd *FoldersCreateCmd) Run(ctx context.Context) error {
@@ -69,20 +74,25 @@ func (cmd *FoldersCreateCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	spaceID, err := cmd.SpaceID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	req := clickup.CreateFolderRequest{Name: cmd.Name}
 
-	result, err := client.Folders().Create(ctx, cmd.SpaceID, req)
+	result, err := client.Folders().Create(ctx, spaceID, req)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME", "SPACE_ID"}
 		rows := [][]string{{result.ID, result.Name, result.Space.ID}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Folder created\n\n")
@@ -94,8 +104,8 @@ func (cmd *FoldersCreateCmd) Run(ctx context.Context) error {
 }
 
 type FoldersUpdateCmd struct {
-	FolderID string `arg:"" required:"" help:"Folder ID"`
-	Name     string `help:"New folder name"`
+	FolderID FolderArg `arg:"" required:"" help:"Folder (ID, name, path or URL)"`
+	Name     string    `help:"New folder name"`
 }
 
 func (cmd *FoldersUpdateCmd) Run(ctx context.Context) error {
@@ -104,20 +114,25 @@ func (cmd *FoldersUpdateCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	folderID, err := cmd.FolderID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	req := clickup.UpdateFolderRequest{Name: cmd.Name}
 
-	result, err := client.Folders().Update(ctx, cmd.FolderID, req)
+	result, err := client.Folders().Update(ctx, folderID, req)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME"}
 		rows := [][]string{{result.ID, result.Name}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Folder updated\n\n")
@@ -128,7 +143,7 @@ func (cmd *FoldersUpdateCmd) Run(ctx context.Context) error {
 }
 
 type FoldersDeleteCmd struct {
-	FolderID string `arg:"" required:"" help:"Folder ID"`
+	FolderID FolderArg `arg:"" required:"" help:"Folder (ID, name, path or URL)"`
 }
 
 func (cmd *FoldersDeleteCmd) Run(ctx context.Context) error {
@@ -137,40 +152,45 @@ func (cmd *FoldersDeleteCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	folderID, err := cmd.FolderID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	if !forceEnabled(ctx) && !outfmt.IsPlain(ctx) && !outfmt.IsJSON(ctx) {
 		fmt.Fprintf(os.Stderr, "WARNING: Deleting a folder will move its lists to folderless.\n")
-		fmt.Fprintf(os.Stderr, "Folder ID: %s\n\n", cmd.FolderID)
+		fmt.Fprintf(os.Stderr, "Folder ID: %s\n\n", folderID)
 		fmt.Fprintf(os.Stderr, "Use --force to skip this confirmation.\n")
 
 		return fmt.Errorf("operation cancelled: use --force to confirm destructive operation")
 	}
 
-	if err := client.Folders().Delete(ctx, cmd.FolderID); err != nil {
+	if err := client.Folders().Delete(ctx, folderID); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":    "success",
 			"message":   "Folder deleted",
-			"folder_id": cmd.FolderID,
+			"folder_id": folderID,
 		})
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "FOLDER_ID"}
-		rows := [][]string{{"success", cmd.FolderID}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		rows := [][]string{{"success", folderID}}
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
-	fmt.Fprintf(os.Stderr, "Folder %s deleted\n", cmd.FolderID)
+	fmt.Fprintf(os.Stderr, "Folder %s deleted\n", folderID)
 
 	return nil
 }
 
 type FoldersFromTemplateCmd struct {
-	SpaceID    string `arg:"" required:"" help:"Space ID"`
-	TemplateID string `arg:"" required:"" help:"Template ID"`
-	Name       string `help:"New folder name (optional, uses template name if not set)"`
+	SpaceID    SpaceArg `arg:"" required:"" help:"Space (ID, name or URL)"`
+	TemplateID string   `arg:"" required:"" help:"Template ID"`
+	Name       string   `help:"New folder name (optional, uses template name if not set)"`
 }
 
 func (cmd *FoldersFromTemplateCmd) Run(ctx context.Context) error {
@@ -179,20 +199,25 @@ func (cmd *FoldersFromTemplateCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	spaceID, err := cmd.SpaceID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	req := clickup.CreateFolderFromTemplateRequest{Name: cmd.Name}
 
-	result, err := client.Folders().CreateFromTemplate(ctx, cmd.SpaceID, cmd.TemplateID, req)
+	result, err := client.Folders().CreateFromTemplate(ctx, spaceID, cmd.TemplateID, req)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME", "SPACE_ID"}
 		rows := [][]string{{result.ID, result.Name, result.Space.ID}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Folder created from template\n\n")
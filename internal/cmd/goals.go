@@ -39,52 +39,27 @@ func (cmd *GoalsListCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "NAME", "PERCENT_COMPLETE", "DUE_DATE", "KEY_RESULT_COUNT"}
-		var rows [][]string
-
-		for _, goal := range result.Goals {
-			rows = append(rows, []string{
-				goal.ID,
-				goal.Name,
-				strconv.Itoa(goal.PercentCompleted),
-				goal.DueDate,
-				strconv.Itoa(len(goal.KeyResults)),
-			})
-		}
+	headers := []string{"ID", "NAME", "PERCENT_COMPLETE", "DUE_DATE", "KEY_RESULT_COUNT"}
+	var rows [][]string
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+	for _, goal := range result.Goals {
+		rows = append(rows, []string{
+			goal.ID,
+			goal.Name,
+			strconv.Itoa(goal.PercentCompleted),
+			outfmt.Timestamp(ctx, goal.DueDate),
+			strconv.Itoa(len(goal.KeyResults)),
+		})
 	}
 
-	// Human-readable output
-	if len(result.Goals) == 0 {
-		fmt.Fprintln(os.Stderr, "No goals found")
-
+	if !reportRows(ctx, len(rows), "goals") {
 		return nil
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d goals:\n\n", len(result.Goals))
-
-	for _, goal := range result.Goals {
-		fmt.Fprintf(os.Stderr, "  %s: %s (%d%% complete)\n", goal.ID, goal.Name, goal.PercentCompleted)
-
-		if goal.DueDate != "" {
-			fmt.Fprintf(os.Stderr, "    Due: %s\n", goal.DueDate)
-		}
-
-		if goal.Description != "" {
-			fmt.Fprintf(os.Stderr, "    Description: %s\n", goal.Description)
-		}
-
-		if len(goal.KeyResults) > 0 {
-			fmt.Fprintf(os.Stderr, "    Key Results: %d\n", len(goal.KeyResults))
-		}
-	}
-
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
 type GoalsGetCmd struct {
@@ -103,7 +78,7 @@ func (cmd *GoalsGetCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
@@ -112,11 +87,11 @@ func (cmd *GoalsGetCmd) Run(ctx context.Context) error {
 			result.ID,
 			result.Name,
 			strconv.Itoa(result.PercentCompleted),
-			result.DueDate,
+			outfmt.Timestamp(ctx, result.DueDate),
 			strconv.Itoa(len(result.KeyResults)),
 		}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	// Human-readable output
@@ -125,7 +100,7 @@ func (cmd *GoalsGetCmd) Run(ctx context.Context) error {
 	fmt.Printf("  Progress: %d%%\n", result.PercentCompleted)
 
 	if result.DueDate != "" {
-		fmt.Printf("  Due: %s\n", result.DueDate)
+		fmt.Printf("  Due: %s\n", outfmt.Timestamp(ctx, result.DueDate))
 	}
 
 	if result.Description != "" {
@@ -175,15 +150,20 @@ func calculateProgress(current, end, start int) int {
 }
 
 type GoalsCreateCmd struct {
-	TeamID      string `arg:"" required:"" help:"Workspace/Team ID"`
-	Name        string `arg:"" required:"" help:"Goal name"`
-	DueDate     int64  `help:"Due date in milliseconds"`
-	Description string `help:"Goal description"`
-	Owners      string `help:"Comma-separated list of owner user IDs"`
-	Color       string `name:"goal-color" help:"Goal color (hex)"`
+	TeamID      string   `arg:"" required:"" help:"Workspace/Team ID"`
+	Name        string   `arg:"" required:"" help:"Goal name"`
+	DueDate     DateFlag `help:"Due date (e.g. 2026-10-20, \"2026-10-20 17:00\", tomorrow, \"next friday\", eow, +3d)" placeholder:"DATE"`
+	Description string   `help:"Goal description"`
+	Owners      string   `help:"Comma-separated list of owner user IDs"`
+	Color       string   `name:"goal-color" help:"Goal color (hex)"`
 }
 
 func (cmd *GoalsCreateCmd) Run(ctx context.Context) error {
+	dueDate, err := cmd.DueDate.Millis(ctx, "--due-date")
+	if err != nil {
+		return err
+	}
+
 	client, err := getClickUpClient(ctx)
 	if err != nil {
 		return err
@@ -191,7 +171,7 @@ func (cmd *GoalsCreateCmd) Run(ctx context.Context) error {
 
 	req := clickup.CreateGoalRequest{
 		Name:        cmd.Name,
-		DueDate:     cmd.DueDate,
+		DueDate:     dueDate,
 		Description: cmd.Description,
 		Color:       cmd.Color,
 	}
@@ -219,14 +199,14 @@ func (cmd *GoalsCreateCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME", "PERCENT_COMPLETE"}
 		rows := [][]string{{result.ID, result.Name, strconv.Itoa(result.PercentCompleted)}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Goal created\n")
@@ -234,23 +214,28 @@ func (cmd *GoalsCreateCmd) Run(ctx context.Context) error {
 	fmt.Printf("  Name: %s\n", result.Name)
 
 	if result.DueDate != "" {
-		fmt.Printf("  Due: %s\n", result.DueDate)
+		fmt.Printf("  Due: %s\n", outfmt.Timestamp(ctx, result.DueDate))
 	}
 
 	return nil
 }
 
 type GoalsUpdateCmd struct {
-	GoalID       string `arg:"" required:"" help:"Goal ID"`
-	Name         string `help:"New goal name"`
-	Description  string `help:"New goal description"`
-	DueDate      int64  `help:"New due date in milliseconds"`
-	Color        string `name:"goal-color" help:"New goal color (hex)"`
-	AddOwners    string `help:"Comma-separated list of owner IDs to add"`
-	RemoveOwners string `help:"Comma-separated list of owner IDs to remove"`
+	GoalID       string   `arg:"" required:"" help:"Goal ID"`
+	Name         string   `help:"New goal name"`
+	Description  string   `help:"New goal description"`
+	DueDate      DateFlag `help:"New due date (e.g. 2026-10-20, \"2026-10-20 17:00\", tomorrow, \"next friday\", eow, +3d)" placeholder:"DATE"`
+	Color        string   `name:"goal-color" help:"New goal color (hex)"`
+	AddOwners    string   `help:"Comma-separated list of owner IDs to add"`
+	RemoveOwners string   `help:"Comma-separated list of owner IDs to remove"`
 }
 
 func (cmd *GoalsUpdateCmd) Run(ctx context.Context) error {
+	dueDate, err := cmd.DueDate.Millis(ctx, "--due-date")
+	if err != nil {
+		return err
+	}
+
 	client, err := getClickUpClient(ctx)
 	if err != nil {
 		return err
@@ -259,7 +244,7 @@ func (cmd *GoalsUpdateCmd) Run(ctx context.Context) error {
 	req := clickup.UpdateGoalRequest{
 		Name:        cmd.Name,
 		Description: cmd.Description,
-		DueDate:     cmd.DueDate,
+		DueDate:     dueDate,
 		Color:       cmd.Color,
 	}
 
@@ -303,14 +288,14 @@ func (cmd *GoalsUpdateCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME", "PERCENT_COMPLETE"}
 		rows := [][]string{{result.ID, result.Name, strconv.Itoa(result.PercentCompleted)}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Goal updated\n")
@@ -343,7 +328,7 @@ func (cmd *GoalsDeleteCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":  "success",
 			"message": "Goal deleted",
 			"goal_id": cmd.GoalID,
@@ -354,7 +339,7 @@ func (cmd *GoalsDeleteCmd) Run(ctx context.Context) error {
 		headers := []string{"STATUS", "GOAL_ID"}
 		rows := [][]string{{"success", cmd.GoalID}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Goal %s deleted\n", cmd.GoalID)
@@ -409,7 +394,7 @@ func (cmd *GoalsAddKeyResultCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
@@ -421,7 +406,7 @@ func (cmd *GoalsAddKeyResultCmd) Run(ctx context.Context) error {
 			fmt.Sprintf("%d/%d", result.StepsCurrent, result.StepsEnd),
 		}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Key result created\n")
@@ -456,7 +441,7 @@ func (cmd *GoalsUpdateKeyResultCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
@@ -468,7 +453,7 @@ func (cmd *GoalsUpdateKeyResultCmd) Run(ctx context.Context) error {
 			fmt.Sprintf("%d/%d", result.StepsCurrent, result.StepsEnd),
 		}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Key result updated\n")
@@ -505,7 +490,7 @@ func (cmd *GoalsDeleteKeyResultCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":        "success",
 			"message":       "Key result deleted",
 			"key_result_id": cmd.KeyResultID,
@@ -516,7 +501,7 @@ func (cmd *GoalsDeleteKeyResultCmd) Run(ctx context.Context) error {
 		headers := []string{"STATUS", "KEY_RESULT_ID"}
 		rows := [][]string{{"success", cmd.KeyResultID}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Key result %s deleted\n", cmd.KeyResultID)
@@ -32,29 +32,20 @@ func (cmd *GroupsListCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
-	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "NAME", "MEMBER_COUNT"}
-		var rows [][]string
-		for _, group := range result.Groups {
-			rows = append(rows, []string{group.ID, group.Name, strconv.Itoa(len(group.Members))})
-		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	if len(result.Groups) == 0 {
-		fmt.Fprintln(os.Stderr, "No user groups found")
-		return nil
+	headers := []string{"ID", "NAME", "MEMBER_COUNT"}
+	var rows [][]string
+	for _, group := range result.Groups {
+		rows = append(rows, []string{group.ID, group.Name, strconv.Itoa(len(group.Members))})
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d user groups\n\n", len(result.Groups))
-
-	for _, group := range result.Groups {
-		printUserGroup(&group)
+	if !reportRows(ctx, len(rows), "user groups") {
+		return nil
 	}
 
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
 type GroupsCreateCmd struct {
@@ -87,12 +78,12 @@ func (cmd *GroupsCreateCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME", "MEMBER_COUNT"}
 		rows := [][]string{{result.ID, result.Name, strconv.Itoa(len(result.Members))}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Created user group\n\n")
@@ -148,12 +139,12 @@ func (cmd *GroupsUpdateCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME", "MEMBER_COUNT"}
 		rows := [][]string{{result.ID, result.Name, strconv.Itoa(len(result.Members))}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Updated user group\n\n")
@@ -177,7 +168,7 @@ func (cmd *GroupsDeleteCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":   "success",
 			"message":  "User group deleted",
 			"group_id": cmd.GroupID,
@@ -186,7 +177,7 @@ func (cmd *GroupsDeleteCmd) Run(ctx context.Context) error {
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "GROUP_ID"}
 		rows := [][]string{{"success", cmd.GroupID}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "User group %s deleted\n", cmd.GroupID)
@@ -219,13 +210,6 @@ func parseMemberIDs(s string) ([]int, error) {
 	return ids, nil
 }
 
-func printUserGroup(group *clickup.UserGroup) {
-	fmt.Printf("ID: %s\n", group.ID)
-	fmt.Printf("  Name: %s\n", group.Name)
-	fmt.Printf("  Members: %d\n", len(group.Members))
-	fmt.Println()
-}
-
 func printUserGroupDetail(group *clickup.UserGroup) {
 	fmt.Printf("ID: %s\n", group.ID)
 	fmt.Printf("Name: %s\n", group.Name)
@@ -40,7 +40,7 @@ func (cmd *GuestsGetCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "USERNAME", "EMAIL", "TASKS", "LISTS", "FOLDERS"}
@@ -52,7 +52,7 @@ func (cmd *GuestsGetCmd) Run(ctx context.Context) error {
 			strconv.Itoa(result.ListsCount),
 			strconv.Itoa(result.FoldersCount),
 		}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	printGuestDetail(result)
@@ -87,7 +87,7 @@ func (cmd *GuestsInviteCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "USERNAME", "EMAIL"}
@@ -96,7 +96,7 @@ func (cmd *GuestsInviteCmd) Run(ctx context.Context) error {
 			result.Username,
 			result.Email,
 		}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Invited guest to workspace\n\n")
@@ -131,7 +131,7 @@ func (cmd *GuestsUpdateCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "USERNAME", "EMAIL"}
@@ -140,7 +140,7 @@ func (cmd *GuestsUpdateCmd) Run(ctx context.Context) error {
 			result.Username,
 			result.Email,
 		}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Updated guest permissions\n\n")
@@ -165,7 +165,7 @@ func (cmd *GuestsRemoveCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":   "success",
 			"message":  "Guest removed from workspace",
 			"guest_id": strconv.Itoa(cmd.GuestID),
@@ -174,7 +174,7 @@ func (cmd *GuestsRemoveCmd) Run(ctx context.Context) error {
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "GUEST_ID"}
 		rows := [][]string{{"success", strconv.Itoa(cmd.GuestID)}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Guest %d removed from workspace\n", cmd.GuestID)
@@ -183,9 +183,9 @@ func (cmd *GuestsRemoveCmd) Run(ctx context.Context) error {
 }
 
 type GuestsAddToTaskCmd struct {
-	TaskID          string `arg:"" required:"" help:"Task ID"`
-	GuestID         int    `arg:"" required:"" help:"Guest ID"`
-	PermissionLevel string `short:"p" required:"" help:"Permission level: read, comment, edit, create"`
+	TaskID          TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
+	GuestID         int     `arg:"" required:"" help:"Guest ID"`
+	PermissionLevel string  `short:"p" required:"" help:"Permission level: read, comment, edit, create"`
 }
 
 func (cmd *GuestsAddToTaskCmd) Run(ctx context.Context) error {
@@ -194,13 +194,18 @@ func (cmd *GuestsAddToTaskCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	result, err := client.Guests().AddToTask(ctx, cmd.TaskID, cmd.GuestID, cmd.PermissionLevel)
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	result, err := client.Guests().AddToTask(ctx, taskID, cmd.GuestID, cmd.PermissionLevel)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "USERNAME", "TASKS"}
@@ -209,7 +214,7 @@ func (cmd *GuestsAddToTaskCmd) Run(ctx context.Context) error {
 			result.Username,
 			strconv.Itoa(result.TasksCount),
 		}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Added guest to task with %s permission\n\n", cmd.PermissionLevel)
@@ -219,8 +224,8 @@ func (cmd *GuestsAddToTaskCmd) Run(ctx context.Context) error {
 }
 
 type GuestsRemoveFromTaskCmd struct {
-	TaskID  string `arg:"" required:"" help:"Task ID"`
-	GuestID int    `arg:"" required:"" help:"Guest ID"`
+	TaskID  TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
+	GuestID int     `arg:"" required:"" help:"Guest ID"`
 }
 
 func (cmd *GuestsRemoveFromTaskCmd) Run(ctx context.Context) error {
@@ -229,33 +234,38 @@ func (cmd *GuestsRemoveFromTaskCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	if err := client.Guests().RemoveFromTask(ctx, cmd.TaskID, cmd.GuestID); err != nil {
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if err := client.Guests().RemoveFromTask(ctx, taskID, cmd.GuestID); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":   "success",
 			"message":  "Guest removed from task",
-			"task_id":  cmd.TaskID,
+			"task_id":  taskID,
 			"guest_id": strconv.Itoa(cmd.GuestID),
 		})
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "TASK_ID", "GUEST_ID"}
-		rows := [][]string{{"success", cmd.TaskID, strconv.Itoa(cmd.GuestID)}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		rows := [][]string{{"success", taskID, strconv.Itoa(cmd.GuestID)}}
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
-	fmt.Fprintf(os.Stderr, "Guest %d removed from task %s\n", cmd.GuestID, cmd.TaskID)
+	fmt.Fprintf(os.Stderr, "Guest %d removed from task %s\n", cmd.GuestID, taskID)
 
 	return nil
 }
 
 type GuestsAddToListCmd struct {
-	ListID          string `arg:"" required:"" help:"List ID"`
-	GuestID         int    `arg:"" required:"" help:"Guest ID"`
-	PermissionLevel string `short:"p" required:"" help:"Permission level: read, comment, edit, create"`
+	ListID          ListArg `arg:"" required:"" help:"List (ID, name, path or URL)"`
+	GuestID         int     `arg:"" required:"" help:"Guest ID"`
+	PermissionLevel string  `short:"p" required:"" help:"Permission level: read, comment, edit, create"`
 }
 
 func (cmd *GuestsAddToListCmd) Run(ctx context.Context) error {
@@ -264,13 +274,18 @@ func (cmd *GuestsAddToListCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	result, err := client.Guests().AddToList(ctx, cmd.ListID, cmd.GuestID, cmd.PermissionLevel)
+	listID, err := cmd.ListID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	result, err := client.Guests().AddToList(ctx, listID, cmd.GuestID, cmd.PermissionLevel)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "USERNAME", "LISTS"}
@@ -279,7 +294,7 @@ func (cmd *GuestsAddToListCmd) Run(ctx context.Context) error {
 			result.Username,
 			strconv.Itoa(result.ListsCount),
 		}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Added guest to list with %s permission\n\n", cmd.PermissionLevel)
@@ -289,8 +304,8 @@ func (cmd *GuestsAddToListCmd) Run(ctx context.Context) error {
 }
 
 type GuestsRemoveFromListCmd struct {
-	ListID  string `arg:"" required:"" help:"List ID"`
-	GuestID int    `arg:"" required:"" help:"Guest ID"`
+	ListID  ListArg `arg:"" required:"" help:"List (ID, name, path or URL)"`
+	GuestID int     `arg:"" required:"" help:"Guest ID"`
 }
 
 func (cmd *GuestsRemoveFromListCmd) Run(ctx context.Context) error {
@@ -299,33 +314,38 @@ func (cmd *GuestsRemoveFromListCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	if err := client.Guests().RemoveFromList(ctx, cmd.ListID, cmd.GuestID); err != nil {
+	listID, err := cmd.ListID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if err := client.Guests().RemoveFromList(ctx, listID, cmd.GuestID); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":   "success",
 			"message":  "Guest removed from list",
-			"list_id":  cmd.ListID,
+			"list_id":  listID,
 			"guest_id": strconv.Itoa(cmd.GuestID),
 		})
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "LIST_ID", "GUEST_ID"}
-		rows := [][]string{{"success", cmd.ListID, strconv.Itoa(cmd.GuestID)}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		rows := [][]string{{"success", listID, strconv.Itoa(cmd.GuestID)}}
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
-	fmt.Fprintf(os.Stderr, "Guest %d removed from list %s\n", cmd.GuestID, cmd.ListID)
+	fmt.Fprintf(os.Stderr, "Guest %d removed from list %s\n", cmd.GuestID, listID)
 
 	return nil
 }
 
 type GuestsAddToFolderCmd struct {
-	FolderID        string `arg:"" required:"" help:"Folder ID"`
-	GuestID         int    `arg:"" required:"" help:"Guest ID"`
-	PermissionLevel string `short:"p" required:"" help:"Permission level: read, comment, edit, create"`
+	FolderID        FolderArg `arg:"" required:"" help:"Folder (ID, name, path or URL)"`
+	GuestID         int       `arg:"" required:"" help:"Guest ID"`
+	PermissionLevel string    `short:"p" required:"" help:"Permission level: read, comment, edit, create"`
 }
 
 func (cmd *GuestsAddToFolderCmd) Run(ctx context.Context) error {
@@ -334,13 +354,18 @@ func (cmd *GuestsAddToFolderCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	result, err := client.Guests().AddToFolder(ctx, cmd.FolderID, cmd.GuestID, cmd.PermissionLevel)
+	folderID, err := cmd.FolderID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	result, err := client.Guests().AddToFolder(ctx, folderID, cmd.GuestID, cmd.PermissionLevel)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "USERNAME", "FOLDERS"}
@@ -349,7 +374,7 @@ func (cmd *GuestsAddToFolderCmd) Run(ctx context.Context) error {
 			result.Username,
 			strconv.Itoa(result.FoldersCount),
 		}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Added guest to folder with %s permission\n\n", cmd.PermissionLevel)
@@ -359,8 +384,8 @@ func (cmd *GuestsAddToFolderCmd) Run(ctx context.Context) error {
 }
 
 type GuestsRemoveFromFolderCmd struct {
-	FolderID string `arg:"" required:"" help:"Folder ID"`
-	GuestID  int    `arg:"" required:"" help:"Guest ID"`
+	FolderID FolderArg `arg:"" required:"" help:"Folder (ID, name, path or URL)"`
+	GuestID  int       `arg:"" required:"" help:"Guest ID"`
 }
 
 func (cmd *GuestsRemoveFromFolderCmd) Run(ctx context.Context) error {
@@ -369,25 +394,30 @@ func (cmd *GuestsRemoveFromFolderCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	if err := client.Guests().RemoveFromFolder(ctx, cmd.FolderID, cmd.GuestID); err != nil {
+	folderID, err := cmd.FolderID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if err := client.Guests().RemoveFromFolder(ctx, folderID, cmd.GuestID); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":    "success",
 			"message":   "Guest removed from folder",
-			"folder_id": cmd.FolderID,
+			"folder_id": folderID,
 			"guest_id":  strconv.Itoa(cmd.GuestID),
 		})
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "FOLDER_ID", "GUEST_ID"}
-		rows := [][]string{{"success", cmd.FolderID, strconv.Itoa(cmd.GuestID)}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		rows := [][]string{{"success", folderID, strconv.Itoa(cmd.GuestID)}}
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
-	fmt.Fprintf(os.Stderr, "Guest %d removed from folder %s\n", cmd.GuestID, cmd.FolderID)
+	fmt.Fprintf(os.Stderr, "Guest %d removed from folder %s\n", cmd.GuestID, folderID)
 
 	return nil
 }
@@ -3,10 +3,14 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 
+	"github.com/builtbyrobben/clickup-cli/internal/api"
 	"github.com/builtbyrobben/clickup-cli/internal/clickup"
 	"github.com/builtbyrobben/clickup-cli/internal/config"
+	"github.com/builtbyrobben/clickup-cli/internal/outfmt"
 	"github.com/builtbyrobben/clickup-cli/internal/secrets"
 )
 
@@ -27,23 +31,93 @@ func getClickUpClient(ctx context.Context) (*clickup.Client, error) {
 		}
 	}
 
+	cred, err := resolveCredential(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := clientOptions(ctx, cred.Token, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if cred.Kind == secrets.CredentialOAuth {
+		opts = append(opts, clickup.WithAPIOptions(api.WithBearerAuth()))
+	}
+
+	return clickup.NewClient(cred.Token, opts...), nil
+}
+
+func resolveCredential(ctx context.Context) (secrets.Credential, error) {
 	// 1. Check env var for API key
 	if key := os.Getenv("CLICKUP_API_KEY"); key != "" {
-		return clickup.NewClient(key, clickup.WithWorkspaceID(workspaceID)), nil
+		return secrets.Credential{Kind: secrets.CredentialAPIKey, Token: key}, nil
+	}
+
+	// Replayed runs never reach the API, so they don't need a token.
+	if rf := getRootFlags(ctx); rf != nil && rf.Replay != "" {
+		return secrets.Credential{}, nil
 	}
 
 	// 2. Check keyring
 	store, err := secrets.OpenDefault()
 	if err != nil {
-		return nil, fmt.Errorf("open credential store: %w", err)
+		return secrets.Credential{}, fmt.Errorf("open credential store: %w", err)
 	}
 
-	key, err := store.GetAPIKey()
+	cred, err := store.GetCredential()
 	if err != nil {
-		return nil, fmt.Errorf("no credentials found; run: clickup-cli auth set-key --stdin")
+		return secrets.Credential{}, fmt.Errorf("no credentials found; run: clickup-cli auth set-key --stdin or clickup-cli auth login")
 	}
 
-	return clickup.NewClient(key, clickup.WithWorkspaceID(workspaceID)), nil
+	return cred, nil
+}
+
+// clientOptions translates root flags into client options.
+func clientOptions(ctx context.Context, apiKey, workspaceID string) ([]clickup.ClientOption, error) {
+	opts := []clickup.ClientOption{clickup.WithWorkspaceID(workspaceID), clickup.WithTeamID(getTeamID)}
+
+	rf := getRootFlags(ctx)
+	if rf == nil {
+		return opts, nil
+	}
+
+	apiOpts := []api.ClientOption{
+		api.WithRetries(rf.Retries),
+		api.WithRetryMaxWait(rf.RetryMaxWait),
+	}
+
+	if rf.DryRun {
+		apiOpts = append(apiOpts, api.WithDryRun(printDryRun(ctx)))
+	}
+
+	switch {
+	case rf.Record != "" && rf.Replay != "":
+		return nil, newUsageError(fmt.Errorf("--record and --replay are mutually exclusive"))
+	case rf.Record != "":
+		apiOpts = append(apiOpts, api.WithRecorder(rf.Record))
+	case rf.Replay != "":
+		replayer, err := api.NewReplayer(rf.Replay)
+		if err != nil {
+			return nil, err
+		}
+
+		apiOpts = append(apiOpts, api.WithReplayer(replayer))
+	case (rf.Cache || rf.Refresh) && !rf.NoCache:
+		// Cassettes must see every request, so the cache only applies to live runs.
+		if dir, err := config.CacheDir(); err == nil {
+			cache := clickup.NewHierarchyCache(dir, apiKey, workspaceID)
+			cache.Refresh = rf.Refresh
+			apiOpts = append(apiOpts, api.WithCache(cache))
+		}
+	}
+
+	// Added last so traces show exactly what went over the wire (or cassette).
+	if rf.Verbose || rf.TraceFile != "" {
+		apiOpts = append(apiOpts, api.WithTracer(api.NewTracer(rf.TraceFile, VersionString())))
+	}
+
+	return append(opts, clickup.WithAPIOptions(apiOpts...)), nil
 }
 
 func getTeamID() (string, error) {
@@ -65,3 +139,44 @@ func getTeamID() (string, error) {
 	// 3. Error with instructions
 	return "", fmt.Errorf("no team ID configured; run: clickup-cli auth set-team <TEAM_ID>")
 }
+
+// reportRows prints the "Found N things" summary on stderr before human
+// table output and reports whether there are rows to write. Plain output
+// always writes its headers, even without rows.
+func reportRows(ctx context.Context, n int, noun string) bool {
+	if outfmt.IsPlain(ctx) {
+		return true
+	}
+
+	if n == 0 {
+		fmt.Fprintf(os.Stderr, "No %s found\n", noun)
+		return false
+	}
+
+	fmt.Fprintf(os.Stderr, "Found %d %s\n\n", n, noun)
+
+	return true
+}
+
+// readText reads a text flag: "-" reads stdin, "@FILE" reads a file, and
+// anything else is the text itself.
+func readText(value string) (string, error) {
+	switch {
+	case value == "-":
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("read stdin: %w", err)
+		}
+
+		return string(data), nil
+	case strings.HasPrefix(value, "@"):
+		data, err := os.ReadFile(value[1:])
+		if err != nil {
+			return "", fmt.Errorf("read %s: %w", value[1:], err)
+		}
+
+		return string(data), nil
+	default:
+		return value, nil
+	}
+}
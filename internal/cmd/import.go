@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/builtbyrobben/clickup-cli/internal/api"
+	"github.com/builtbyrobben/clickup-cli/internal/clickup"
+	"github.com/builtbyrobben/clickup-cli/internal/outfmt"
+	"github.com/builtbyrobben/clickup-cli/internal/taskimport"
+)
+
+// TasksImportCmd creates tasks from the rows of a CSV or JSON file.
+type TasksImportCmd struct {
+	File     string   `arg:"" required:"" type:"existingfile" help:"CSV file with a header row, or JSON array of objects"`
+	List     ListArg  `required:"" help:"List (ID, name, path or URL) to create the tasks in"`
+	Map      []string `help:"Send a column to a task attribute: key, name, description, status, assignee, tags, due, priority, parent or field:NAME (can be repeated). Unmapped attributes use the column of the same name" placeholder:"TARGET=COLUMN"`
+	Validate bool     `help:"Only check the file and mapping against the list; create nothing"`
+	State    string   `help:"Progress file used to resume an interrupted import (default: FILE.state.json)" type:"path"`
+	Out      string   `help:"Write the external key to task ID map to this file (.json or .csv)" type:"path"`
+}
+
+func (cmd *TasksImportCmd) Run(ctx context.Context) error {
+	mapping, err := taskimport.ParseMapping(cmd.Map)
+	if err != nil {
+		return newUsageError(err)
+	}
+
+	headers, rows, err := taskimport.Read(cmd.File)
+	if err != nil {
+		return err
+	}
+
+	if err := mapping.Complete(headers); err != nil {
+		return newUsageError(err)
+	}
+
+	client, err := getClickUpClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	listID, err := cmd.List.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	fields, err := client.CustomFields().ListByList(ctx, listID)
+	if err != nil {
+		return err
+	}
+
+	if err := mapping.CheckFields(fields.Fields); err != nil {
+		return newUsageError(err)
+	}
+
+	loc := outfmt.FromContext(ctx).Time.Location
+	if loc == nil {
+		loc = time.Local
+	}
+
+	resolver := newResolver(ctx, client)
+
+	tasks, errs := taskimport.Build(rows, mapping, taskimport.Options{
+		Fields: fields.Fields,
+		User: func(ref string) (int, error) {
+			return resolveRef(resolver.User(ctx, ref))
+		},
+		Now: time.Now().In(loc),
+	})
+	if len(errs) > 0 {
+		for _, err := range errs {
+			fmt.Fprintln(os.Stderr, err)
+		}
+
+		return fmt.Errorf("%d rows failed validation; no tasks were created", len(errs))
+	}
+
+	statePath := cmd.State
+	if statePath == "" {
+		statePath = cmd.File + ".state.json"
+	}
+
+	state, err := taskimport.LoadState(statePath)
+	if err != nil {
+		return err
+	}
+
+	if state.List != "" && state.List != listID {
+		return newUsageError(fmt.Errorf("state file %s belongs to an import into list %s; pass another --state", statePath, state.List))
+	}
+
+	state.List = listID
+
+	if cmd.Validate {
+		fmt.Fprintf(os.Stderr, "%d rows are valid; %d already imported\n", len(tasks), countImported(tasks, state))
+		return nil
+	}
+
+	return cmd.create(ctx, client, listID, tasks, state, statePath)
+}
+
+// create makes the tasks in order, recording each one in the state file as
+// soon as it exists. Tasks already in the state are skipped.
+func (cmd *TasksImportCmd) create(ctx context.Context, client *clickup.Client, listID string, tasks []taskimport.Task, state *taskimport.State, statePath string) error {
+	created := make(map[string]string, len(tasks))
+	for key, id := range state.Created {
+		created[key] = id
+	}
+
+	results := make([]taskimport.Result, 0, len(tasks))
+
+	for _, task := range tasks {
+		if id, ok := created[task.Key]; ok {
+			results = append(results, taskimport.Result{Key: task.Key, TaskID: id, Line: task.Line})
+			continue
+		}
+
+		req := task.Request
+		if task.ParentKey != "" {
+			req.Parent = created[task.ParentKey]
+		}
+
+		result, err := client.Tasks().Create(ctx, listID, req)
+		if errors.Is(err, api.ErrDryRun) {
+			created[task.Key] = "(" + task.Key + ")"
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("row %d (%s): %w; rerun the import to resume from %s", task.Line, task.Key, err, statePath)
+		}
+
+		created[task.Key] = result.ID
+
+		if err := state.Record(task.Key, result.ID); err != nil {
+			return err
+		}
+
+		results = append(results, taskimport.Result{Key: task.Key, TaskID: result.ID, Line: task.Line})
+
+		if !outfmt.IsJSON(ctx) && !outfmt.IsPlain(ctx) {
+			fmt.Fprintf(os.Stderr, "Created %s -> %s\n", task.Key, result.ID)
+		}
+	}
+
+	if dryRunEnabled(ctx) {
+		return api.ErrDryRun
+	}
+
+	if cmd.Out != "" {
+		if err := taskimport.WriteResults(cmd.Out, results); err != nil {
+			return err
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(ctx, os.Stdout, results)
+	}
+
+	if outfmt.IsPlain(ctx) {
+		headers := []string{"KEY", "TASK_ID", "LINE"}
+		rows := make([][]string, 0, len(results))
+
+		for _, r := range results {
+			rows = append(rows, []string{r.Key, r.TaskID, strconv.Itoa(r.Line)})
+		}
+
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
+	}
+
+	fmt.Fprintf(os.Stderr, "Imported %d tasks into list %s\n", len(results), listID)
+
+	return nil
+}
+
+func countImported(tasks []taskimport.Task, state *taskimport.State) int {
+	n := 0
+
+	for _, task := range tasks {
+		if _, ok := state.Created[task.Key]; ok {
+			n++
+		}
+	}
+
+	return n
+}
@@ -22,8 +22,8 @@ type ListsCmd struct {
 }
 
 type ListsListCmd struct {
-	Space  string `help:"Space ID to list from (shows folders + folderless lists)"`
-	Folder string `help:"Folder ID to list from"`
+	Space  SpaceArg  `help:"Space (ID, name or URL) to list from (shows folders + folderless lists)"`
+	Folder FolderArg `help:"Folder (ID, name, path or URL) to list from"`
 }
 
 func (cmd *ListsListCmd) Run(ctx context.Context) error {
@@ -32,68 +32,67 @@ func (cmd *ListsListCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	if cmd.Folder == "" && cmd.Space == "" {
+	spaceID, err := cmd.Space.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	folderID, err := cmd.Folder.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if folderID == "" && spaceID == "" {
 		return fmt.Errorf("either --space or --folder is required")
 	}
 
 	// If folder is specified, list from folder
-	if cmd.Folder != "" {
-		return cmd.listByFolder(ctx, client)
+	if folderID != "" {
+		return cmd.listByFolder(ctx, client, folderID)
 	}
 
 	// Otherwise list from space (folders + folderless lists)
-	return cmd.listBySpace(ctx, client)
+	return cmd.listBySpace(ctx, client, spaceID)
 }
 
-func (cmd *ListsListCmd) listByFolder(ctx context.Context, client *clickup.Client) error {
-	result, err := client.Lists().ListByFolder(ctx, cmd.Folder)
+func (cmd *ListsListCmd) listByFolder(ctx context.Context, client *clickup.Client, folderID string) error {
+	result, err := client.Lists().ListByFolder(ctx, folderID)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
-	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "NAME"}
-		var rows [][]string
-		for _, list := range result.Lists {
-			rows = append(rows, []string{list.ID, list.Name})
-		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	if len(result.Lists) == 0 {
-		fmt.Fprintln(os.Stderr, "No lists found")
-		return nil
+	headers := []string{"ID", "NAME"}
+	var rows [][]string
+	for _, list := range result.Lists {
+		rows = append(rows, []string{list.ID, list.Name})
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d lists\n\n", len(result.Lists))
-
-	for _, list := range result.Lists {
-		fmt.Printf("ID: %s\n", list.ID)
-		fmt.Printf("  Name: %s\n", list.Name)
-		fmt.Println()
+	if !reportRows(ctx, len(rows), "lists") {
+		return nil
 	}
 
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
-func (cmd *ListsListCmd) listBySpace(ctx context.Context, client *clickup.Client) error {
+func (cmd *ListsListCmd) listBySpace(ctx context.Context, client *clickup.Client, spaceID string) error {
 	// Get folders and their lists
-	folders, err := client.Lists().ListFolders(ctx, cmd.Space)
+	folders, err := client.Lists().ListFolders(ctx, spaceID)
 	if err != nil {
 		return err
 	}
 
 	// Get folderless lists
-	folderless, err := client.Lists().ListFolderless(ctx, cmd.Space)
+	folderless, err := client.Lists().ListFolderless(ctx, spaceID)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]any{
 			"folders":          folders.Folders,
 			"folderless_lists": folderless.Lists,
 		})
@@ -109,7 +108,7 @@ func (cmd *ListsListCmd) listBySpace(ctx context.Context, client *clickup.Client
 		for _, list := range folderless.Lists {
 			rows = append(rows, []string{list.ID, list.Name, ""})
 		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	if len(folders.Folders) == 0 && len(folderless.Lists) == 0 {
@@ -144,7 +143,7 @@ func (cmd *ListsListCmd) listBySpace(ctx context.Context, client *clickup.Client
 }
 
 type ListsGetCmd struct {
-	ListID string `arg:"" required:"" help:"List ID"`
+	ListID ListArg `arg:"" required:"" help:"List (ID, name, path or URL)"`
 }
 
 func (cmd *ListsGetCmd) Run(ctx context.Context) error {
@@ -153,13 +152,18 @@ func (cmd *ListsGetCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	result, err := client.Lists().Get(ctx, cmd.ListID)
+	listID, err := cmd.ListID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	result, err := client.Lists().Get(ctx, listID)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME", "TASK_COUNT", "FOLDER", "SPACE"}
@@ -170,7 +174,7 @@ func (cmd *ListsGetCmd) Run(ctx context.Context) error {
 			result.Folder.Name,
 			result.Space.ID,
 		}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "List Details\n\n")
@@ -184,43 +188,63 @@ func (cmd *ListsGetCmd) Run(ctx context.Context) error {
 }
 
 type ListsCreateCmd struct {
-	Name     string `arg:"" required:"" help:"List name"`
-	Folder   string `help:"Folder ID to create list in (required unless --space is set)"`
-	Space    string `help:"Space ID for folderless list (required unless --folder is set)"`
-	Content  string `help:"List description"`
-	DueDate  int64  `help:"Due date in milliseconds"`
-	Priority int    `help:"Priority (1-4)"`
-	Assignee int    `help:"Assignee user ID"`
+	Name     string    `arg:"" required:"" help:"List name"`
+	Folder   FolderArg `help:"Folder (ID, name, path or URL) to create list in (required unless --space is set)"`
+	Space    SpaceArg  `help:"Space (ID, name or URL) for folderless list (required unless --folder is set)"`
+	Content  string    `help:"List description"`
+	DueDate  DateFlag  `help:"Due date (e.g. 2026-10-20, \"2026-10-20 17:00\", tomorrow, \"next friday\", eow, +3d)" placeholder:"DATE"`
+	Priority int       `help:"Priority (1-4)"`
+	Assignee UserArg   `help:"Assignee (user ID, email or @username)"`
 }
 
 func (cmd *ListsCreateCmd) Run(ctx context.Context) error {
+	dueDate, err := cmd.DueDate.Millis(ctx, "--due-date")
+	if err != nil {
+		return err
+	}
+
 	client, err := getClickUpClient(ctx)
 	if err != nil {
 		return err
 	}
 
-	if cmd.Folder == "" && cmd.Space == "" {
+	folderID, err := cmd.Folder.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	spaceID, err := cmd.Space.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	assignee, err := cmd.Assignee.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if folderID == "" && spaceID == "" {
 		return fmt.Errorf("either --folder or --space is required")
 	}
 
-	if cmd.Folder != "" && cmd.Space != "" {
+	if folderID != "" && spaceID != "" {
 		return fmt.Errorf("only one of --folder or --space can be set, not both")
 	}
 
 	req := clickup.CreateListRequest{
 		Name:     cmd.Name,
 		Content:  cmd.Content,
-		DueDate:  cmd.DueDate,
+		DueDate:  dueDate,
 		Priority: cmd.Priority,
-		Assignee: cmd.Assignee,
+		Assignee: assignee,
 	}
 
 	var result *clickup.ListDetail
 
-	if cmd.Folder != "" {
-		result, err = client.Lists().CreateInFolder(ctx, cmd.Folder, req)
+	if folderID != "" {
+		result, err = client.Lists().CreateInFolder(ctx, folderID, req)
 	} else {
-		result, err = client.Lists().CreateFolderless(ctx, cmd.Space, req)
+		result, err = client.Lists().CreateFolderless(ctx, spaceID, req)
 	}
 
 	if err != nil {
@@ -228,12 +252,12 @@ func (cmd *ListsCreateCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME", "TASK_COUNT"}
 		rows := [][]string{{result.ID, result.Name, strconv.Itoa(result.TaskCount)}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "List created\n\n")
@@ -244,42 +268,57 @@ func (cmd *ListsCreateCmd) Run(ctx context.Context) error {
 }
 
 type ListsUpdateCmd struct {
-	ListID        string `arg:"" required:"" help:"List ID"`
-	Name          string `help:"New list name"`
-	Content       string `help:"List description"`
-	DueDate       int64  `help:"Due date in milliseconds"`
-	Priority      int    `help:"Priority (1-4)"`
-	Assignee      int    `help:"Assignee user ID"`
-	UnsetAssignee bool   `help:"Remove assignee from list"`
+	ListID        ListArg  `arg:"" required:"" help:"List (ID, name, path or URL)"`
+	Name          string   `help:"New list name"`
+	Content       string   `help:"List description"`
+	DueDate       DateFlag `help:"Due date (e.g. 2026-10-20, \"2026-10-20 17:00\", tomorrow, \"next friday\", eow, +3d)" placeholder:"DATE"`
+	Priority      int      `help:"Priority (1-4)"`
+	Assignee      UserArg  `help:"Assignee (user ID, email or @username)"`
+	UnsetAssignee bool     `help:"Remove assignee from list"`
 }
 
 func (cmd *ListsUpdateCmd) Run(ctx context.Context) error {
+	dueDate, err := cmd.DueDate.Millis(ctx, "--due-date")
+	if err != nil {
+		return err
+	}
+
 	client, err := getClickUpClient(ctx)
 	if err != nil {
 		return err
 	}
 
+	listID, err := cmd.ListID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	assignee, err := cmd.Assignee.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	req := clickup.UpdateListRequest{
 		Name:          cmd.Name,
 		Content:       cmd.Content,
-		DueDate:       cmd.DueDate,
+		DueDate:       dueDate,
 		Priority:      cmd.Priority,
-		Assignee:      cmd.Assignee,
+		Assignee:      assignee,
 		UnsetAssignee: cmd.UnsetAssignee,
 	}
 
-	result, err := client.Lists().Update(ctx, cmd.ListID, req)
+	result, err := client.Lists().Update(ctx, listID, req)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME", "TASK_COUNT"}
 		rows := [][]string{{result.ID, result.Name, strconv.Itoa(result.TaskCount)}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "List updated\n\n")
@@ -290,7 +329,7 @@ func (cmd *ListsUpdateCmd) Run(ctx context.Context) error {
 }
 
 type ListsDeleteCmd struct {
-	ListID string `arg:"" required:"" help:"List ID"`
+	ListID ListArg `arg:"" required:"" help:"List (ID, name, path or URL)"`
 }
 
 func (cmd *ListsDeleteCmd) Run(ctx context.Context) error {
@@ -299,41 +338,46 @@ func (cmd *ListsDeleteCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	listID, err := cmd.ListID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	if !forceEnabled(ctx) && !outfmt.IsPlain(ctx) && !outfmt.IsJSON(ctx) {
 		fmt.Fprintf(os.Stderr, "WARNING: Deleting a list will delete all tasks in it.\n")
-		fmt.Fprintf(os.Stderr, "List ID: %s\n\n", cmd.ListID)
+		fmt.Fprintf(os.Stderr, "List ID: %s\n\n", listID)
 		fmt.Fprintf(os.Stderr, "Use --force to skip this confirmation.\n")
 
 		return fmt.Errorf("operation cancelled: use --force to confirm destructive operation")
 	}
 
-	if err := client.Lists().Delete(ctx, cmd.ListID); err != nil {
+	if err := client.Lists().Delete(ctx, listID); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":  "success",
 			"message": "List deleted",
-			"list_id": cmd.ListID,
+			"list_id": listID,
 		})
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "LIST_ID"}
-		rows := [][]string{{"success", cmd.ListID}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		rows := [][]string{{"success", listID}}
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
-	fmt.Fprintf(os.Stderr, "List %s deleted\n", cmd.ListID)
+	fmt.Fprintf(os.Stderr, "List %s deleted\n", listID)
 
 	return nil
 }
 
 type ListsFromTemplateCmd struct {
-	TemplateID string `arg:"" required:"" help:"Template ID"`
-	Name       string `arg:"" required:"" help:"New list name"`
-	Folder     string `help:"Folder ID to create list in (required unless --space is set)"`
-	Space      string `help:"Space ID for folderless list (required unless --folder is set)"`
+	TemplateID string    `arg:"" required:"" help:"Template ID"`
+	Name       string    `arg:"" required:"" help:"New list name"`
+	Folder     FolderArg `help:"Folder (ID, name, path or URL) to create list in (required unless --space is set)"`
+	Space      SpaceArg  `help:"Space (ID, name or URL) for folderless list (required unless --folder is set)"`
 }
 
 func (cmd *ListsFromTemplateCmd) Run(ctx context.Context) error {
@@ -342,11 +386,21 @@ func (cmd *ListsFromTemplateCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	if cmd.Folder == "" && cmd.Space == "" {
+	folderID, err := cmd.Folder.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	spaceID, err := cmd.Space.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if folderID == "" && spaceID == "" {
 		return fmt.Errorf("either --folder or --space is required")
 	}
 
-	if cmd.Folder != "" && cmd.Space != "" {
+	if folderID != "" && spaceID != "" {
 		return fmt.Errorf("only one of --folder or --space can be set, not both")
 	}
 
@@ -356,10 +410,10 @@ func (cmd *ListsFromTemplateCmd) Run(ctx context.Context) error {
 
 	var result *clickup.ListDetail
 
-	if cmd.Folder != "" {
-		result, err = client.Lists().CreateFromTemplateInFolder(ctx, cmd.Folder, cmd.TemplateID, req)
+	if folderID != "" {
+		result, err = client.Lists().CreateFromTemplateInFolder(ctx, folderID, cmd.TemplateID, req)
 	} else {
-		result, err = client.Lists().CreateFromTemplateInSpace(ctx, cmd.Space, cmd.TemplateID, req)
+		result, err = client.Lists().CreateFromTemplateInSpace(ctx, spaceID, cmd.TemplateID, req)
 	}
 
 	if err != nil {
@@ -367,12 +421,12 @@ func (cmd *ListsFromTemplateCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME"}
 		rows := [][]string{{result.ID, result.Name}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "List created from template\n\n")
@@ -383,8 +437,8 @@ func (cmd *ListsFromTemplateCmd) Run(ctx context.Context) error {
 }
 
 type ListsAddTaskCmd struct {
-	ListID string `arg:"" required:"" help:"List ID"`
-	TaskID string `arg:"" required:"" help:"Task ID"`
+	ListID ListArg `arg:"" required:"" help:"List (ID, name, path or URL)"`
+	TaskID TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
 }
 
 func (cmd *ListsAddTaskCmd) Run(ctx context.Context) error {
@@ -393,32 +447,42 @@ func (cmd *ListsAddTaskCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	if err := client.Lists().AddTask(ctx, cmd.ListID, cmd.TaskID); err != nil {
+	listID, err := cmd.ListID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if err := client.Lists().AddTask(ctx, listID, taskID); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":  "success",
 			"message": "Task added to list",
-			"list_id": cmd.ListID,
-			"task_id": cmd.TaskID,
+			"list_id": listID,
+			"task_id": taskID,
 		})
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "LIST_ID", "TASK_ID"}
-		rows := [][]string{{"success", cmd.ListID, cmd.TaskID}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		rows := [][]string{{"success", listID, taskID}}
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
-	fmt.Fprintf(os.Stderr, "Task %s added to list %s\n", cmd.TaskID, cmd.ListID)
+	fmt.Fprintf(os.Stderr, "Task %s added to list %s\n", taskID, listID)
 
 	return nil
 }
 
 type ListsRemoveTaskCmd struct {
-	ListID string `arg:"" required:"" help:"List ID"`
-	TaskID string `arg:"" required:"" help:"Task ID"`
+	ListID ListArg `arg:"" required:"" help:"List (ID, name, path or URL)"`
+	TaskID TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
 }
 
 func (cmd *ListsRemoveTaskCmd) Run(ctx context.Context) error {
@@ -427,25 +491,35 @@ func (cmd *ListsRemoveTaskCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	if err := client.Lists().RemoveTask(ctx, cmd.ListID, cmd.TaskID); err != nil {
+	listID, err := cmd.ListID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if err := client.Lists().RemoveTask(ctx, listID, taskID); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":  "success",
 			"message": "Task removed from list",
-			"list_id": cmd.ListID,
-			"task_id": cmd.TaskID,
+			"list_id": listID,
+			"task_id": taskID,
 		})
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "LIST_ID", "TASK_ID"}
-		rows := [][]string{{"success", cmd.ListID, cmd.TaskID}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		rows := [][]string{{"success", listID, taskID}}
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
-	fmt.Fprintf(os.Stderr, "Task %s removed from list %s\n", cmd.TaskID, cmd.ListID)
+	fmt.Fprintf(os.Stderr, "Task %s removed from list %s\n", taskID, listID)
 
 	return nil
 }
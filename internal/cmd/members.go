@@ -33,40 +33,24 @@ func (cmd *MembersListCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
-	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "USERNAME", "EMAIL"}
-		var rows [][]string
-		for _, member := range result.Members {
-			rows = append(rows, []string{fmt.Sprintf("%d", member.User.ID), member.User.Username, member.User.Email})
-		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
-	}
-
-	if len(result.Members) == 0 {
-		fmt.Fprintln(os.Stderr, "No members found")
-		return nil
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d members\n\n", len(result.Members))
-
+	headers := []string{"ID", "USERNAME", "EMAIL"}
+	var rows [][]string
 	for _, member := range result.Members {
-		fmt.Printf("ID: %d\n", member.User.ID)
-		fmt.Printf("  Username: %s\n", member.User.Username)
-
-		if member.User.Email != "" {
-			fmt.Printf("  Email: %s\n", member.User.Email)
-		}
+		rows = append(rows, []string{fmt.Sprintf("%d", member.User.ID), member.User.Username, member.User.Email})
+	}
 
-		fmt.Println()
+	if !reportRows(ctx, len(rows), "members") {
+		return nil
 	}
 
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
 type MembersListMembersCmd struct {
-	List string `name:"list" help:"List ID" required:""`
+	List ListArg `name:"list" help:"List (ID, name, path or URL)" required:""`
 }
 
 func (cmd *MembersListMembersCmd) Run(ctx context.Context) error {
@@ -75,46 +59,35 @@ func (cmd *MembersListMembersCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	result, err := client.Members().ListMembers(ctx, cmd.List)
+	listID, err := cmd.List.Resolve(ctx, client)
 	if err != nil {
 		return err
 	}
 
-	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
-	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "USERNAME", "EMAIL"}
-		rows := make([][]string, 0, len(result.Members))
-		for _, member := range result.Members {
-			rows = append(rows, []string{fmt.Sprintf("%d", member.ID), member.Username, member.Email})
-		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+	result, err := client.Members().ListMembers(ctx, listID)
+	if err != nil {
+		return err
 	}
 
-	if len(result.Members) == 0 {
-		fmt.Fprintln(os.Stderr, "No members found")
-		return nil
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d members with access to this list\n\n", len(result.Members))
-
+	headers := []string{"ID", "USERNAME", "EMAIL"}
+	rows := make([][]string, 0, len(result.Members))
 	for _, member := range result.Members {
-		fmt.Printf("ID: %d\n", member.ID)
-		fmt.Printf("  Username: %s\n", member.Username)
-
-		if member.Email != "" {
-			fmt.Printf("  Email: %s\n", member.Email)
-		}
+		rows = append(rows, []string{fmt.Sprintf("%d", member.ID), member.Username, member.Email})
+	}
 
-		fmt.Println()
+	if !reportRows(ctx, len(rows), "members") {
+		return nil
 	}
 
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
 type MembersTaskMembersCmd struct {
-	Task string `name:"task" help:"Task ID" required:""`
+	Task TaskArg `name:"task" help:"Task (ID, custom ID or URL)" required:""`
 }
 
 func (cmd *MembersTaskMembersCmd) Run(ctx context.Context) error {
@@ -123,40 +96,29 @@ func (cmd *MembersTaskMembersCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	result, err := client.Members().TaskMembers(ctx, cmd.Task)
+	taskID, err := cmd.Task.Resolve(ctx, client)
 	if err != nil {
 		return err
 	}
 
-	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
-	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "USERNAME", "EMAIL"}
-		rows := make([][]string, 0, len(result.Members))
-		for _, member := range result.Members {
-			rows = append(rows, []string{fmt.Sprintf("%d", member.ID), member.Username, member.Email})
-		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+	result, err := client.Members().TaskMembers(ctx, taskID)
+	if err != nil {
+		return err
 	}
 
-	if len(result.Members) == 0 {
-		fmt.Fprintln(os.Stderr, "No members found")
-		return nil
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d members involved with this task\n\n", len(result.Members))
-
+	headers := []string{"ID", "USERNAME", "EMAIL"}
+	rows := make([][]string, 0, len(result.Members))
 	for _, member := range result.Members {
-		fmt.Printf("ID: %d\n", member.ID)
-		fmt.Printf("  Username: %s\n", member.Username)
-
-		if member.Email != "" {
-			fmt.Printf("  Email: %s\n", member.Email)
-		}
+		rows = append(rows, []string{fmt.Sprintf("%d", member.ID), member.Username, member.Email})
+	}
 
-		fmt.Println()
+	if !reportRows(ctx, len(rows), "members") {
+		return nil
 	}
 
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
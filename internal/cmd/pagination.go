@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"os"
+
+	"github.com/builtbyrobben/clickup-cli/internal/clickup"
+	"github.com/builtbyrobben/clickup-cli/internal/outfmt"
+)
+
+// PaginationFlags adds --all/--limit to commands backed by paged endpoints.
+type PaginationFlags struct {
+	All   bool `help:"Fetch every page of results"`
+	Limit int  `help:"Stop after N results, fetching as many pages as needed"`
+}
+
+func (p PaginationFlags) enabled() bool {
+	return p.All || p.Limit > 0
+}
+
+// streamPages drains seq, calling emit for each item until limit items have
+// been emitted (limit <= 0 means no cap). It returns the number emitted.
+func streamPages[T any](seq iter.Seq2[T, error], limit int, emit func(T) error) (int, error) {
+	n := 0
+
+	for item, err := range seq {
+		if err != nil {
+			return n, err
+		}
+
+		if err := emit(item); err != nil {
+			return n, err
+		}
+
+		n++
+		if limit > 0 && n >= limit {
+			break
+		}
+	}
+
+	return n, nil
+}
+
+// writeJSONStream writes items from seq in the active JSON mode. NDJSON is
+// written one item per line as pages arrive; JSON is collected and passed
+// through wrap so it keeps the single-page response shape.
+func writeJSONStream[T any](ctx context.Context, seq iter.Seq2[T, error], limit int, wrap func([]T) any) error {
+	if outfmt.IsNDJSON(ctx) {
+		_, err := streamPages(seq, limit, func(item T) error {
+			return outfmt.WriteJSONLine(ctx, os.Stdout, item)
+		})
+
+		return err
+	}
+
+	items := []T{}
+
+	if _, err := streamPages(seq, limit, func(item T) error {
+		items = append(items, item)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return outfmt.WriteJSON(ctx, os.Stdout, wrap(items))
+}
+
+// writeTaskStream renders tasks from a paginated iterator in the active output
+// mode. Rows are written as pages arrive (the table aligns them once the last
+// page is in); JSON is collected and passed through wrap so it keeps the
+// single-page response shape.
+func writeTaskStream(
+	ctx context.Context,
+	seq iter.Seq2[clickup.Task, error],
+	limit int,
+	headers []string,
+	row func(*clickup.Task) []outfmt.Cell,
+	wrap func([]clickup.Task) any,
+) error {
+	if outfmt.IsJSON(ctx) {
+		return writeJSONStream(ctx, seq, limit, wrap)
+	}
+
+	return writeTaskRows(ctx, seq, limit, headers, row)
+}
+
+// writeTaskRows writes tasks from seq as plain or table rows. Human output
+// reports the task count on stderr.
+func writeTaskRows(
+	ctx context.Context,
+	seq iter.Seq2[clickup.Task, error],
+	limit int,
+	headers []string,
+	row func(*clickup.Task) []outfmt.Cell,
+) error {
+	rw := outfmt.NewWriter(ctx, os.Stdout)
+
+	if err := rw.WriteHeader(headers); err != nil {
+		return err
+	}
+
+	n, err := streamPages(seq, limit, func(task clickup.Task) error {
+		return outfmt.WriteCells(rw, row(&task))
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := rw.Flush(); err != nil {
+		return err
+	}
+
+	if outfmt.IsPlain(ctx) {
+		return nil
+	}
+
+	if n == 0 {
+		fmt.Fprintln(os.Stderr, "No tasks found")
+		return nil
+	}
+
+	fmt.Fprintf(os.Stderr, "Found %d tasks\n", n)
+
+	return nil
+}
+
+// taskSeq adapts a single page of tasks to the iterator writeTaskRows takes.
+func taskSeq(tasks []clickup.Task) iter.Seq2[clickup.Task, error] {
+	return func(yield func(clickup.Task, error) bool) {
+		for _, task := range tasks {
+			if !yield(task, nil) {
+				return
+			}
+		}
+	}
+}
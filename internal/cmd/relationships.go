@@ -17,9 +17,9 @@ type RelationshipsCmd struct {
 }
 
 type RelationshipsAddDepCmd struct {
-	TaskID       string `arg:"" required:"" help:"Task ID"`
-	DependsOn    string `help:"Task ID that this task depends on (this task waits for other)"`
-	DependencyOf string `help:"Task ID that depends on this task (this task blocks other)"`
+	TaskID       TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
+	DependsOn    string  `help:"Task ID that this task depends on (this task waits for other)"`
+	DependencyOf string  `help:"Task ID that depends on this task (this task blocks other)"`
 }
 
 func (cmd *RelationshipsAddDepCmd) Run(ctx context.Context) error {
@@ -28,6 +28,11 @@ func (cmd *RelationshipsAddDepCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	if cmd.DependsOn == "" && cmd.DependencyOf == "" {
 		return fmt.Errorf("either --depends-on or --dependency-of must be specified")
 	}
@@ -37,15 +42,15 @@ func (cmd *RelationshipsAddDepCmd) Run(ctx context.Context) error {
 		DependencyOf: cmd.DependencyOf,
 	}
 
-	if err := client.Relationships().AddDependency(ctx, cmd.TaskID, req); err != nil {
+	if err := client.Relationships().AddDependency(ctx, taskID, req); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":  "success",
 			"message": "Dependency added",
-			"task_id": cmd.TaskID,
+			"task_id": taskID,
 		})
 	}
 
@@ -58,24 +63,24 @@ func (cmd *RelationshipsAddDepCmd) Run(ctx context.Context) error {
 			other = cmd.DependencyOf
 		}
 
-		rows := [][]string{{"success", cmd.TaskID + " -> " + other}}
+		rows := [][]string{{"success", taskID + " -> " + other}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	if cmd.DependsOn != "" {
-		fmt.Fprintf(os.Stderr, "Dependency added: task %s now depends on %s\n", cmd.TaskID, cmd.DependsOn)
+		fmt.Fprintf(os.Stderr, "Dependency added: task %s now depends on %s\n", taskID, cmd.DependsOn)
 	} else {
-		fmt.Fprintf(os.Stderr, "Dependency added: task %s now blocks %s\n", cmd.TaskID, cmd.DependencyOf)
+		fmt.Fprintf(os.Stderr, "Dependency added: task %s now blocks %s\n", taskID, cmd.DependencyOf)
 	}
 
 	return nil
 }
 
 type RelationshipsRemoveDepCmd struct {
-	TaskID       string `arg:"" required:"" help:"Task ID"`
-	DependsOn    string `help:"Task ID to remove as a dependency (this task was waiting for other)"`
-	DependencyOf string `help:"Task ID to remove as dependent (this task was blocking other)"`
+	TaskID       TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
+	DependsOn    string  `help:"Task ID to remove as a dependency (this task was waiting for other)"`
+	DependencyOf string  `help:"Task ID to remove as dependent (this task was blocking other)"`
 }
 
 func (cmd *RelationshipsRemoveDepCmd) Run(ctx context.Context) error {
@@ -84,6 +89,11 @@ func (cmd *RelationshipsRemoveDepCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	if cmd.DependsOn == "" && cmd.DependencyOf == "" {
 		return fmt.Errorf("either --depends-on or --dependency-of must be specified")
 	}
@@ -93,33 +103,33 @@ func (cmd *RelationshipsRemoveDepCmd) Run(ctx context.Context) error {
 		DependencyOf: cmd.DependencyOf,
 	}
 
-	if err := client.Relationships().DeleteDependency(ctx, cmd.TaskID, req); err != nil {
+	if err := client.Relationships().DeleteDependency(ctx, taskID, req); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":  "success",
 			"message": "Dependency removed",
-			"task_id": cmd.TaskID,
+			"task_id": taskID,
 		})
 	}
 
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "TASK_ID"}
-		rows := [][]string{{"success", cmd.TaskID}}
+		rows := [][]string{{"success", taskID}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
-	fmt.Fprintf(os.Stderr, "Dependency removed from task %s\n", cmd.TaskID)
+	fmt.Fprintf(os.Stderr, "Dependency removed from task %s\n", taskID)
 
 	return nil
 }
 
 type RelationshipsLinkCmd struct {
-	TaskID       string `arg:"" required:"" help:"Task ID"`
-	LinkedTaskID string `arg:"" required:"" help:"Task ID to link to"`
+	TaskID       TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
+	LinkedTaskID TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL) to link to"`
 }
 
 func (cmd *RelationshipsLinkCmd) Run(ctx context.Context) error {
@@ -128,34 +138,44 @@ func (cmd *RelationshipsLinkCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	if err := client.Relationships().AddLink(ctx, cmd.TaskID, cmd.LinkedTaskID); err != nil {
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	linkedTaskID, err := cmd.LinkedTaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if err := client.Relationships().AddLink(ctx, taskID, linkedTaskID); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":    "success",
 			"message":   "Task link added",
-			"task_id":   cmd.TaskID,
-			"linked_to": cmd.LinkedTaskID,
+			"task_id":   taskID,
+			"linked_to": linkedTaskID,
 		})
 	}
 
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "TASK_ID", "LINKED_TO"}
-		rows := [][]string{{"success", cmd.TaskID, cmd.LinkedTaskID}}
+		rows := [][]string{{"success", taskID, linkedTaskID}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
-	fmt.Fprintf(os.Stderr, "Tasks linked: %s <-> %s\n", cmd.TaskID, cmd.LinkedTaskID)
+	fmt.Fprintf(os.Stderr, "Tasks linked: %s <-> %s\n", taskID, linkedTaskID)
 
 	return nil
 }
 
 type RelationshipsUnlinkCmd struct {
-	TaskID       string `arg:"" required:"" help:"Task ID"`
-	LinkedTaskID string `arg:"" required:"" help:"Task ID to unlink from"`
+	TaskID       TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
+	LinkedTaskID TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL) to unlink from"`
 }
 
 func (cmd *RelationshipsUnlinkCmd) Run(ctx context.Context) error {
@@ -164,27 +184,37 @@ func (cmd *RelationshipsUnlinkCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	if err := client.Relationships().DeleteLink(ctx, cmd.TaskID, cmd.LinkedTaskID); err != nil {
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	linkedTaskID, err := cmd.LinkedTaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if err := client.Relationships().DeleteLink(ctx, taskID, linkedTaskID); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":   "success",
 			"message":  "Task link removed",
-			"task_id":  cmd.TaskID,
-			"unlinked": cmd.LinkedTaskID,
+			"task_id":  taskID,
+			"unlinked": linkedTaskID,
 		})
 	}
 
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "TASK_ID", "UNLINKED"}
-		rows := [][]string{{"success", cmd.TaskID, cmd.LinkedTaskID}}
+		rows := [][]string{{"success", taskID, linkedTaskID}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
-	fmt.Fprintf(os.Stderr, "Tasks unlinked: %s <-> %s\n", cmd.TaskID, cmd.LinkedTaskID)
+	fmt.Fprintf(os.Stderr, "Tasks unlinked: %s <-> %s\n", taskID, linkedTaskID)
 
 	return nil
 }
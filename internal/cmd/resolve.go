@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/term"
+
+	"github.com/builtbyrobben/clickup-cli/internal/clickup"
+)
+
+// SpaceArg, FolderArg and ListArg accept an ID, a name, a path such as
+// "Engineering/Sprint 42" or a ClickUp URL, and resolve to the canonical ID
+// when the command runs. Numeric IDs are used as is, without any request.
+type (
+	SpaceArg  string
+	FolderArg string
+	ListArg   string
+)
+
+// TaskArg accepts a task ID or a task URL.
+type TaskArg string
+
+// UserArg accepts a user ID, an email or an @username.
+type UserArg string
+
+func (a SpaceArg) Resolve(ctx context.Context, client *clickup.Client) (string, error) {
+	return resolveRef(newResolver(ctx, client).Space(ctx, string(a)))
+}
+
+func (a FolderArg) Resolve(ctx context.Context, client *clickup.Client) (string, error) {
+	return resolveRef(newResolver(ctx, client).Folder(ctx, string(a)))
+}
+
+func (a ListArg) Resolve(ctx context.Context, client *clickup.Client) (string, error) {
+	return resolveRef(newResolver(ctx, client).List(ctx, string(a)))
+}
+
+func (a TaskArg) Resolve(ctx context.Context, client *clickup.Client) (string, error) {
+	return resolveRef(newResolver(ctx, client).Task(ctx, string(a)))
+}
+
+func (a UserArg) Resolve(ctx context.Context, client *clickup.Client) (int, error) {
+	return resolveRef(newResolver(ctx, client).User(ctx, string(a)))
+}
+
+// resolveTasks resolves each task argument in turn.
+func resolveTasks(ctx context.Context, client *clickup.Client, args []TaskArg) ([]string, error) {
+	ids := make([]string, 0, len(args))
+
+	for _, arg := range args {
+		id, err := arg.Resolve(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// resolveUsers resolves each user argument with one resolver, so the member
+// list is fetched once.
+func resolveUsers(ctx context.Context, client *clickup.Client, args []UserArg) ([]int, error) {
+	resolver := newResolver(ctx, client)
+	ids := make([]int, 0, len(args))
+
+	for _, arg := range args {
+		id, err := resolveRef(resolver.User(ctx, string(arg)))
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// resolveRef turns an ambiguous name that could not be settled by a prompt
+// into a usage error.
+func resolveRef[T any](id T, err error) (T, error) {
+	var amb *clickup.AmbiguousError
+	if errors.As(err, &amb) {
+		return id, newUsageError(err)
+	}
+
+	return id, err
+}
+
+func newResolver(ctx context.Context, client *clickup.Client) *clickup.Resolver {
+	resolver := clickup.NewResolver(client, getTeamID)
+
+	if rf := getRootFlags(ctx); (rf == nil || !rf.NoInput) && term.IsTerminal(int(os.Stdin.Fd())) {
+		resolver.Choose = promptMatch
+	}
+
+	return resolver
+}
+
+// promptMatch asks which of several matches an ambiguous name meant.
+func promptMatch(amb *clickup.AmbiguousError) (clickup.Match, error) {
+	fmt.Fprintf(os.Stderr, "%q matches %d %ss:\n", amb.Input, len(amb.Matches), amb.Kind)
+
+	for i, m := range amb.Matches {
+		fmt.Fprintf(os.Stderr, "  %d) %s (%s)\n", i+1, m.Path, m.ID)
+	}
+
+	fmt.Fprintf(os.Stderr, "Choose 1-%d: ", len(amb.Matches))
+
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return clickup.Match{}, fmt.Errorf("read choice: %w", err)
+	}
+
+	n, err := strconv.Atoi(strings.TrimSpace(line))
+	if err != nil || n < 1 || n > len(amb.Matches) {
+		return clickup.Match{}, amb
+	}
+
+	return amb.Matches[n-1], nil
+}
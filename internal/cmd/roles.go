@@ -2,11 +2,9 @@ package cmd
 
 import (
 	"context"
-	"fmt"
 	"os"
 	"strconv"
 
-	"github.com/builtbyrobben/clickup-cli/internal/clickup"
 	"github.com/builtbyrobben/clickup-cli/internal/outfmt"
 )
 
@@ -30,38 +28,22 @@ func (cmd *RolesListCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
-	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "NAME", "PERMISSION_COUNT"}
-		var rows [][]string
-		for _, role := range result.CustomRoles {
-			rows = append(rows, []string{
-				strconv.Itoa(role.ID),
-				role.Name,
-				strconv.Itoa(len(role.Permissions)),
-			})
-		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
-	}
-
-	if len(result.CustomRoles) == 0 {
-		fmt.Fprintln(os.Stderr, "No custom roles found")
-		return nil
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d custom roles\n\n", len(result.CustomRoles))
-
+	headers := []string{"ID", "NAME", "PERMISSION_COUNT"}
+	var rows [][]string
 	for _, role := range result.CustomRoles {
-		printCustomRole(&role)
+		rows = append(rows, []string{
+			strconv.Itoa(role.ID),
+			role.Name,
+			strconv.Itoa(len(role.Permissions)),
+		})
 	}
 
-	return nil
-}
+	if !reportRows(ctx, len(rows), "custom roles") {
+		return nil
+	}
 
-func printCustomRole(role *clickup.CustomRole) {
-	fmt.Printf("ID: %d\n", role.ID)
-	fmt.Printf("  Name: %s\n", role.Name)
-	fmt.Printf("  Permissions: %d\n", len(role.Permissions))
-	fmt.Println()
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
@@ -6,21 +6,41 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"time"
 
 	"github.com/alecthomas/kong"
 
+	"github.com/builtbyrobben/clickup-cli/internal/api"
+	"github.com/builtbyrobben/clickup-cli/internal/config"
 	"github.com/builtbyrobben/clickup-cli/internal/errfmt"
 	"github.com/builtbyrobben/clickup-cli/internal/outfmt"
 )
 
 type RootFlags struct {
-	Color     string `help:"Color output: auto|always|never" default:"${color}"`
-	JSON      bool   `help:"Output JSON to stdout (best for scripting)" default:"${json}"`
-	Plain     bool   `help:"Output stable, parseable text to stdout (TSV; no colors)" default:"${plain}"`
-	Force     bool   `help:"Skip confirmations for destructive commands"`
-	NoInput   bool   `help:"Never prompt; fail instead (useful for CI)"`
-	Verbose   bool   `help:"Enable verbose logging"`
-	Workspace string `help:"Workspace ID for v3 API calls (required for Chat, Docs, etc.)" default:"${workspace}"`
+	Color        string        `help:"Color output: auto|always|never" default:"${color}"`
+	Output       string        `help:"Output format: json|ndjson|csv|tsv|table (env CLICKUP_CLI_OUTPUT)" short:"o" placeholder:"FORMAT"`
+	JSON         bool          `help:"Output JSON to stdout (same as --output json)"`
+	Plain        bool          `help:"Output stable, parseable text to stdout (same as --output tsv)"`
+	Query        string        `help:"Filter JSON output with a jq expression (implies --output json)" placeholder:"EXPR"`
+	Template     string        `help:"Render output with a Go text/template, inline or @FILE (implies --output json)" placeholder:"TEMPLATE"`
+	FieldList    string        `name:"fields" help:"Keep only these comma-separated dotted fields in JSON output, e.g. id,name,status.status (implies --output json)" placeholder:"FIELDS"`
+	TZ           string        `name:"tz" help:"Time zone for displayed times, e.g. Europe/Berlin or UTC (default local)" placeholder:"ZONE"`
+	TimeFormat   string        `help:"Displayed time format: absolute|relative|iso or a Go layout" default:"absolute" placeholder:"FORMAT"`
+	Humanize     bool          `help:"Render timestamps in --time-format in JSON and plain output too"`
+	Force        bool          `help:"Skip confirmations for destructive commands"`
+	NoInput      bool          `help:"Never prompt; fail instead (useful for CI)"`
+	Verbose      bool          `help:"Enable verbose logging"`
+	TraceFile    string        `help:"Write a HAR 1.2 trace of all HTTP traffic to this file (Authorization masked)" type:"path"`
+	Workspace    string        `help:"Workspace ID for v3 API calls (required for Chat, Docs, etc.)" default:"${workspace}"`
+	Profile      string        `help:"Named profile for credentials and team/workspace IDs" default:"${profile}"`
+	Retries      int           `help:"Retry rate-limited requests and failed idempotent requests this many times" default:"3"`
+	RetryMaxWait time.Duration `help:"Maximum wait between retries (caps Retry-After)" default:"30s"`
+	DryRun       bool          `help:"Print non-GET requests instead of sending them"`
+	Cache        bool          `help:"Cache workspace hierarchy reads on disk" env:"CLICKUP_CLI_CACHE"`
+	NoCache      bool          `help:"Bypass the on-disk cache, overriding --cache"`
+	Refresh      bool          `help:"Refetch cached hierarchy reads and update the cache (implies --cache)"`
+	Record       string        `help:"Record HTTP traffic to a cassette file" type:"path" hidden:""`
+	Replay       string        `help:"Replay HTTP traffic from a cassette file instead of calling the API" type:"path" hidden:""`
 }
 
 type CLI struct {
@@ -30,6 +50,7 @@ type CLI struct {
 	Auth          AuthCmd          `cmd:"" help:"Auth and credentials"`
 	Workspaces    WorkspacesCmd    `cmd:"" help:"Workspace operations"`
 	Tasks         TasksCmd         `cmd:"" help:"Task operations"`
+	Export        ExportCmd        `cmd:"" help:"Export tasks to CSV, JSON or Markdown"`
 	Spaces        SpacesCmd        `cmd:"" help:"Space operations"`
 	Folders       FoldersCmd       `cmd:"" help:"Folder operations"`
 	Lists         ListsCmd         `cmd:"" help:"List operations"`
@@ -56,6 +77,7 @@ type CLI struct {
 	Attachments   AttachmentsCmd   `cmd:"" help:"File attachment operations"`
 	Chat          ChatCmd          `cmd:"" help:"Chat operations (v3 API)"`
 	Docs          DocsCmd          `cmd:"" help:"Docs operations (v3 API)"`
+	Cache         CacheCmd         `cmd:"" help:"Manage the local response cache"`
 	VersionCmd    VersionCmd       `cmd:"" name:"version" help:"Print version"`
 }
 
@@ -87,9 +109,20 @@ func getRootFlags(ctx context.Context) *RootFlags {
 	return nil
 }
 
+// profileCreatingCommands may name a profile that does not exist yet with
+// --profile; they create it.
+var profileCreatingCommands = map[string]bool{
+	"auth set-key":       true,
+	"auth set-team":      true,
+	"auth set-workspace": true,
+	"auth login":         true,
+}
+
+// forceEnabled reports whether destructive commands may skip confirmation.
+// Dry runs never send the request, so they don't need confirming either.
 func forceEnabled(ctx context.Context) bool {
 	rf := getRootFlags(ctx)
-	return rf != nil && rf.Force
+	return rf != nil && (rf.Force || rf.DryRun)
 }
 
 func Execute(args []string) (err error) {
@@ -128,12 +161,19 @@ func Execute(args []string) (err error) {
 		Level: logLevel,
 	})))
 
-	mode, err := outfmt.FromFlags(cli.JSON, cli.Plain)
+	mode, err := outputMode(cli)
 	if err != nil {
 		_, _ = fmt.Fprintln(os.Stderr, errfmt.Format(err))
 		return newUsageError(err)
 	}
 
+	create := kctx.Selected() != nil && profileCreatingCommands[kctx.Selected().Path()]
+
+	if err := config.SetActiveProfile(cli.Profile, create); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, errfmt.Format(err))
+		return newUsageError(err)
+	}
+
 	ctx := context.Background()
 	ctx = outfmt.WithMode(ctx, mode)
 	ctx = withWorkspaceID(ctx, cli.Workspace)
@@ -147,9 +187,51 @@ func Execute(args []string) (err error) {
 		return nil
 	}
 
+	if errors.Is(err, api.ErrDryRun) {
+		_, _ = fmt.Fprintln(os.Stderr, "Dry run: request not sent")
+		return nil
+	}
+
 	_, _ = fmt.Fprintln(os.Stderr, errfmt.Format(err))
 
-	return err
+	return withExitCode(err)
+}
+
+// Exit codes, so scripts can branch on the class of failure.
+const (
+	exitCodeError        = 1
+	exitCodeUsage        = 2
+	exitCodeUnauthorized = 3
+	exitCodeForbidden    = 4
+	exitCodeNotFound     = 5
+	exitCodeRateLimited  = 6
+	exitCodePlanLimit    = 7
+)
+
+// withExitCode wraps API failures in an ExitError carrying their class's exit
+// code. Errors that already carry a code are returned unchanged.
+func withExitCode(err error) error {
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		return err
+	}
+
+	code := exitCodeError
+
+	switch {
+	case errors.Is(err, api.ErrUnauthorized):
+		code = exitCodeUnauthorized
+	case errors.Is(err, api.ErrForbidden):
+		code = exitCodeForbidden
+	case errors.Is(err, api.ErrNotFound):
+		code = exitCodeNotFound
+	case errors.Is(err, api.ErrRateLimited):
+		code = exitCodeRateLimited
+	case errors.Is(err, api.ErrPlanLimit):
+		code = exitCodePlanLimit
+	}
+
+	return &ExitError{Code: code, Err: err}
 }
 
 func wrapParseError(err error) error {
@@ -159,7 +241,7 @@ func wrapParseError(err error) error {
 
 	var parseErr *kong.ParseError
 	if errors.As(err, &parseErr) {
-		return &ExitError{Code: 2, Err: parseErr}
+		return &ExitError{Code: exitCodeUsage, Err: parseErr}
 	}
 
 	return err
@@ -173,22 +255,90 @@ func envOr(key, fallback string) string {
 	return fallback
 }
 
-func boolString(v bool) string {
-	if v {
-		return "true"
+// outputMode resolves the output format: flags win over CLICKUP_CLI_OUTPUT
+// (and the older CLICKUP_CLI_JSON/CLICKUP_CLI_PLAIN), then human output.
+// --query, --fields and --template select JSON when no format was chosen
+// and are rejected with csv, tsv or table.
+func outputMode(cli *CLI) (outfmt.Mode, error) {
+	mode, err := baseOutputMode(cli)
+	if err != nil {
+		return mode, err
+	}
+
+	mode.Color, err = outfmt.ParseColor(cli.Color)
+	if err != nil {
+		return mode, err
+	}
+
+	mode.Time, err = outfmt.ParseTimeStyle(cli.TimeFormat, cli.TZ)
+	if err != nil {
+		return mode, err
+	}
+
+	mode.Humanize = cli.Humanize
+
+	if cli.Template != "" {
+		return templateMode(cli, mode)
+	}
+
+	if cli.Query == "" && cli.FieldList == "" {
+		if mode.Format == "" {
+			mode.Format = outfmt.FormatTable
+		}
+
+		return mode, nil
+	}
+
+	switch mode.Format {
+	case "":
+		mode.Format = outfmt.FormatJSON
+	case outfmt.FormatJSON, outfmt.FormatNDJSON:
+	default:
+		return mode, fmt.Errorf("--query and --fields require --output json or ndjson, not %s", mode.Format)
+	}
+
+	if cli.Query != "" {
+		mode.Query, err = outfmt.ParseQuery(cli.Query)
+		if err != nil {
+			return mode, err
+		}
+	}
+
+	mode.Fields = outfmt.ParseFields(cli.FieldList)
+
+	return mode, nil
+}
+
+func templateMode(cli *CLI, mode outfmt.Mode) (outfmt.Mode, error) {
+	if cli.Query != "" || cli.FieldList != "" {
+		return mode, errors.New("--template cannot be combined with --query or --fields")
+	}
+
+	if mode.Format != "" && mode.Format != outfmt.FormatJSON {
+		return mode, fmt.Errorf("--template cannot be combined with --output %s", mode.Format)
 	}
 
-	return "false"
+	tmpl, err := outfmt.ParseTemplate(cli.Template, mode)
+	if err != nil {
+		return mode, err
+	}
+
+	mode.Format = outfmt.FormatJSON
+	mode.Template = tmpl
+
+	return mode, nil
+}
+
+func baseOutputMode(cli *CLI) (outfmt.Mode, error) {
+	return outfmt.Resolve(cli.Output, cli.JSON, cli.Plain, "CLICKUP_CLI")
 }
 
 func newParser(description string) (*kong.Kong, *CLI, error) {
-	envMode := outfmt.FromEnv("CLICKUP_CLI")
 	vars := kong.Vars{
 		"color":     envOr("CLICKUP_CLI_COLOR", "auto"),
-		"json":      boolString(envMode.JSON),
-		"plain":     boolString(envMode.Plain),
 		"version":   VersionString(),
 		"workspace": envOr("CLICKUP_WORKSPACE_ID", ""),
+		"profile":   envOr("CLICKUP_PROFILE", ""),
 	}
 
 	cli := &CLI{}
@@ -222,5 +372,5 @@ func newUsageError(err error) error {
 		return nil
 	}
 
-	return &ExitError{Code: 2, Err: err}
+	return &ExitError{Code: exitCodeUsage, Err: err}
 }
@@ -29,7 +29,7 @@ func (cmd *SharedListCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"TYPE", "ID", "NAME"}
@@ -43,7 +43,7 @@ func (cmd *SharedListCmd) Run(ctx context.Context) error {
 		for _, folder := range result.Shared.Folders {
 			rows = append(rows, []string{"folder", folder.ID, folder.Name})
 		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	// Human-readable output
@@ -38,35 +38,24 @@ func (cmd *SpacesListCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
-	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "NAME"}
-		var rows [][]string
-		for _, space := range result.Spaces {
-			rows = append(rows, []string{space.ID, space.Name})
-		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	if len(result.Spaces) == 0 {
-		fmt.Fprintln(os.Stderr, "No spaces found")
-		return nil
+	headers := []string{"ID", "NAME"}
+	var rows [][]string
+	for _, space := range result.Spaces {
+		rows = append(rows, []string{space.ID, space.Name})
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d spaces\n\n", len(result.Spaces))
-
-	for _, space := range result.Spaces {
-		fmt.Printf("ID: %s\n", space.ID)
-		fmt.Printf("  Name: %s\n", space.Name)
-		fmt.Println()
+	if !reportRows(ctx, len(rows), "spaces") {
+		return nil
 	}
 
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
 type SpacesGetCmd struct {
-	SpaceID string `arg:"" required:"" help:"Space ID"`
+	SpaceID SpaceArg `arg:"" required:"" help:"Space (ID, name or URL)"`
 }
 
 func (cmd *SpacesGetCmd) Run(ctx context.Context) error {
@@ -75,19 +64,24 @@ func (cmd *SpacesGetCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	result, err := client.Spaces().Get(ctx, cmd.SpaceID)
+	spaceID, err := cmd.SpaceID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	result, err := client.Spaces().Get(ctx, spaceID)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME", "PRIVATE", "STATUS_COUNT"}
 		statusCount := strconv.Itoa(len(result.Statuses))
 		rows := [][]string{{result.ID, result.Name, strconv.FormatBool(result.Private), statusCount}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Space Details\n\n")
@@ -154,12 +148,12 @@ func (cmd *SpacesCreateCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME", "PRIVATE"}
 		rows := [][]string{{result.ID, result.Name, strconv.FormatBool(result.Private)}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Space created\n\n")
@@ -171,12 +165,12 @@ func (cmd *SpacesCreateCmd) Run(ctx context.Context) error {
 }
 
 type SpacesUpdateCmd struct {
-	SpaceID           string `arg:"" required:"" help:"Space ID"`
-	Name              string `help:"New space name"`
-	Color             string `name:"space-color" help:"Space color (hex)"`
-	Private           bool   `help:"Make space private"`
-	Public            bool   `help:"Make space public"`
-	MultipleAssignees bool   `help:"Enable multiple assignees"`
+	SpaceID           SpaceArg `arg:"" required:"" help:"Space (ID, name or URL)"`
+	Name              string   `help:"New space name"`
+	Color             string   `name:"space-color" help:"Space color (hex)"`
+	Private           bool     `help:"Make space private"`
+	Public            bool     `help:"Make space public"`
+	MultipleAssignees bool     `help:"Enable multiple assignees"`
 }
 
 func (cmd *SpacesUpdateCmd) Run(ctx context.Context) error {
@@ -185,6 +179,11 @@ func (cmd *SpacesUpdateCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	spaceID, err := cmd.SpaceID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	req := clickup.UpdateSpaceRequest{
 		Name:  cmd.Name,
 		Color: cmd.Color,
@@ -203,18 +202,18 @@ func (cmd *SpacesUpdateCmd) Run(ctx context.Context) error {
 		req.MultipleAssignees = &ma
 	}
 
-	result, err := client.Spaces().Update(ctx, cmd.SpaceID, req)
+	result, err := client.Spaces().Update(ctx, spaceID, req)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME", "PRIVATE"}
 		rows := [][]string{{result.ID, result.Name, strconv.FormatBool(result.Private)}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Space updated\n\n")
@@ -226,7 +225,7 @@ func (cmd *SpacesUpdateCmd) Run(ctx context.Context) error {
 }
 
 type SpacesDeleteCmd struct {
-	SpaceID string `arg:"" required:"" help:"Space ID"`
+	SpaceID SpaceArg `arg:"" required:"" help:"Space (ID, name or URL)"`
 }
 
 func (cmd *SpacesDeleteCmd) Run(ctx context.Context) error {
@@ -235,32 +234,37 @@ func (cmd *SpacesDeleteCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	spaceID, err := cmd.SpaceID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	if !forceEnabled(ctx) && !outfmt.IsPlain(ctx) && !outfmt.IsJSON(ctx) {
 		fmt.Fprintf(os.Stderr, "WARNING: Deleting a space will delete all folders, lists, and tasks within it.\n")
-		fmt.Fprintf(os.Stderr, "Space ID: %s\n\n", cmd.SpaceID)
+		fmt.Fprintf(os.Stderr, "Space ID: %s\n\n", spaceID)
 		fmt.Fprintf(os.Stderr, "Use --force to skip this confirmation.\n")
 
 		return fmt.Errorf("operation cancelled: use --force to confirm destructive operation")
 	}
 
-	if err := client.Spaces().Delete(ctx, cmd.SpaceID); err != nil {
+	if err := client.Spaces().Delete(ctx, spaceID); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":   "success",
 			"message":  "Space deleted",
-			"space_id": cmd.SpaceID,
+			"space_id": spaceID,
 		})
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "SPACE_ID"}
-		rows := [][]string{{"success", cmd.SpaceID}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		rows := [][]string{{"success", spaceID}}
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
-	fmt.Fprintf(os.Stderr, "Space %s deleted\n", cmd.SpaceID)
+	fmt.Fprintf(os.Stderr, "Space %s deleted\n", spaceID)
 
 	return nil
 }
@@ -19,7 +19,7 @@ type TagsCmd struct {
 }
 
 type TagsListCmd struct {
-	SpaceID string `required:"" help:"Space ID"`
+	SpaceID SpaceArg `required:"" help:"Space (ID, name or URL)"`
 }
 
 func (cmd *TagsListCmd) Run(ctx context.Context) error {
@@ -28,13 +28,18 @@ func (cmd *TagsListCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	result, err := client.Tags().List(ctx, cmd.SpaceID)
+	spaceID, err := cmd.SpaceID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	result, err := client.Tags().List(ctx, spaceID)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
@@ -44,7 +49,7 @@ func (cmd *TagsListCmd) Run(ctx context.Context) error {
 			rows = append(rows, []string{tag.Name, tag.TagFg, tag.TagBg})
 		}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	if len(result.Tags) == 0 {
@@ -52,7 +57,7 @@ func (cmd *TagsListCmd) Run(ctx context.Context) error {
 		return nil
 	}
 
-	fmt.Fprintf(os.Stderr, "Tags in space %s\n\n", cmd.SpaceID)
+	fmt.Fprintf(os.Stderr, "Tags in space %s\n\n", spaceID)
 
 	for _, tag := range result.Tags {
 		if tag.TagBg != "" {
@@ -66,10 +71,10 @@ func (cmd *TagsListCmd) Run(ctx context.Context) error {
 }
 
 type TagsCreateCmd struct {
-	SpaceID string `required:"" help:"Space ID"`
-	Name    string `arg:"" required:"" help:"Tag name"`
-	Bg      string `help:"Background color (hex, e.g., #f44336)"`
-	Fg      string `help:"Foreground color (hex, e.g., #ffffff)"`
+	SpaceID SpaceArg `required:"" help:"Space (ID, name or URL)"`
+	Name    string   `arg:"" required:"" help:"Tag name"`
+	Bg      string   `help:"Background color (hex, e.g., #f44336)"`
+	Fg      string   `help:"Foreground color (hex, e.g., #ffffff)"`
 }
 
 func (cmd *TagsCreateCmd) Run(ctx context.Context) error {
@@ -78,6 +83,11 @@ func (cmd *TagsCreateCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	spaceID, err := cmd.SpaceID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	req := clickup.CreateSpaceTagRequest{
 		Tag: clickup.SpaceTag{
 			Name:  cmd.Name,
@@ -86,12 +96,12 @@ func (cmd *TagsCreateCmd) Run(ctx context.Context) error {
 		},
 	}
 
-	if err := client.Tags().Create(ctx, cmd.SpaceID, req); err != nil {
+	if err := client.Tags().Create(ctx, spaceID, req); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":  "success",
 			"message": "Tag created",
 			"name":    cmd.Name,
@@ -102,7 +112,7 @@ func (cmd *TagsCreateCmd) Run(ctx context.Context) error {
 		headers := []string{"STATUS", "NAME"}
 		rows := [][]string{{"success", cmd.Name}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Tag '%s' created\n", cmd.Name)
@@ -111,11 +121,11 @@ func (cmd *TagsCreateCmd) Run(ctx context.Context) error {
 }
 
 type TagsUpdateCmd struct {
-	SpaceID string `required:"" help:"Space ID"`
-	Name    string `arg:"" required:"" help:"Current tag name"`
-	NewName string `help:"New tag name"`
-	Bg      string `help:"Background color (hex, e.g., #f44336)"`
-	Fg      string `help:"Foreground color (hex, e.g., #ffffff)"`
+	SpaceID SpaceArg `required:"" help:"Space (ID, name or URL)"`
+	Name    string   `arg:"" required:"" help:"Current tag name"`
+	NewName string   `help:"New tag name"`
+	Bg      string   `help:"Background color (hex, e.g., #f44336)"`
+	Fg      string   `help:"Foreground color (hex, e.g., #ffffff)"`
 }
 
 func (cmd *TagsUpdateCmd) Run(ctx context.Context) error {
@@ -124,8 +134,13 @@ func (cmd *TagsUpdateCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	spaceID, err := cmd.SpaceID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	// Get current tag to preserve unspecified fields
-	result, err := client.Tags().List(ctx, cmd.SpaceID)
+	result, err := client.Tags().List(ctx, spaceID)
 	if err != nil {
 		return err
 	}
@@ -164,12 +179,12 @@ func (cmd *TagsUpdateCmd) Run(ctx context.Context) error {
 
 	req := clickup.EditSpaceTagRequest{Tag: tag}
 
-	if err := client.Tags().Update(ctx, cmd.SpaceID, cmd.Name, req); err != nil {
+	if err := client.Tags().Update(ctx, spaceID, cmd.Name, req); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":  "success",
 			"message": "Tag updated",
 			"name":    tag.Name,
@@ -180,7 +195,7 @@ func (cmd *TagsUpdateCmd) Run(ctx context.Context) error {
 		headers := []string{"STATUS", "NAME"}
 		rows := [][]string{{"success", tag.Name}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Tag '%s' updated\n", cmd.Name)
@@ -189,8 +204,8 @@ func (cmd *TagsUpdateCmd) Run(ctx context.Context) error {
 }
 
 type TagsDeleteCmd struct {
-	SpaceID string `required:"" help:"Space ID"`
-	Name    string `arg:"" required:"" help:"Tag name"`
+	SpaceID SpaceArg `required:"" help:"Space (ID, name or URL)"`
+	Name    string   `arg:"" required:"" help:"Tag name"`
 }
 
 func (cmd *TagsDeleteCmd) Run(ctx context.Context) error {
@@ -199,19 +214,24 @@ func (cmd *TagsDeleteCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	spaceID, err := cmd.SpaceID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	if !forceEnabled(ctx) {
-		fmt.Fprintf(os.Stderr, "Warning: This will permanently delete tag '%s' from space %s\n", cmd.Name, cmd.SpaceID)
+		fmt.Fprintf(os.Stderr, "Warning: This will permanently delete tag '%s' from space %s\n", cmd.Name, spaceID)
 		fmt.Fprint(os.Stderr, "Use --force to confirm deletion\n")
 
 		return fmt.Errorf("operation cancelled: use --force to confirm")
 	}
 
-	if err := client.Tags().Delete(ctx, cmd.SpaceID, cmd.Name); err != nil {
+	if err := client.Tags().Delete(ctx, spaceID, cmd.Name); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":  "success",
 			"message": "Tag deleted",
 			"name":    cmd.Name,
@@ -222,7 +242,7 @@ func (cmd *TagsDeleteCmd) Run(ctx context.Context) error {
 		headers := []string{"STATUS", "NAME"}
 		rows := [][]string{{"success", cmd.Name}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Tag '%s' deleted\n", cmd.Name)
@@ -231,8 +251,8 @@ func (cmd *TagsDeleteCmd) Run(ctx context.Context) error {
 }
 
 type TagsAddCmd struct {
-	TaskID string `required:"" help:"Task ID"`
-	Name   string `arg:"" required:"" help:"Tag name"`
+	TaskID TaskArg `required:"" help:"Task (ID, custom ID or URL)"`
+	Name   string  `arg:"" required:"" help:"Tag name"`
 }
 
 func (cmd *TagsAddCmd) Run(ctx context.Context) error {
@@ -241,34 +261,39 @@ func (cmd *TagsAddCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	if err := client.Tags().AddToTask(ctx, cmd.TaskID, cmd.Name); err != nil {
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if err := client.Tags().AddToTask(ctx, taskID, cmd.Name); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":  "success",
 			"message": "Tag added to task",
-			"task_id": cmd.TaskID,
+			"task_id": taskID,
 			"tag":     cmd.Name,
 		})
 	}
 
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "TASK_ID", "TAG"}
-		rows := [][]string{{"success", cmd.TaskID, cmd.Name}}
+		rows := [][]string{{"success", taskID, cmd.Name}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
-	fmt.Fprintf(os.Stderr, "Tag '%s' added to task %s\n", cmd.Name, cmd.TaskID)
+	fmt.Fprintf(os.Stderr, "Tag '%s' added to task %s\n", cmd.Name, taskID)
 
 	return nil
 }
 
 type TagsRemoveCmd struct {
-	TaskID string `required:"" help:"Task ID"`
-	Name   string `arg:"" required:"" help:"Tag name"`
+	TaskID TaskArg `required:"" help:"Task (ID, custom ID or URL)"`
+	Name   string  `arg:"" required:"" help:"Tag name"`
 }
 
 func (cmd *TagsRemoveCmd) Run(ctx context.Context) error {
@@ -277,27 +302,32 @@ func (cmd *TagsRemoveCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	if err := client.Tags().RemoveFromTask(ctx, cmd.TaskID, cmd.Name); err != nil {
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if err := client.Tags().RemoveFromTask(ctx, taskID, cmd.Name); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":  "success",
 			"message": "Tag removed from task",
-			"task_id": cmd.TaskID,
+			"task_id": taskID,
 			"tag":     cmd.Name,
 		})
 	}
 
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "TASK_ID", "TAG"}
-		rows := [][]string{{"success", cmd.TaskID, cmd.Name}}
+		rows := [][]string{{"success", taskID, cmd.Name}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
-	fmt.Fprintf(os.Stderr, "Tag '%s' removed from task %s\n", cmd.Name, cmd.TaskID)
+	fmt.Fprintf(os.Stderr, "Tag '%s' removed from task %s\n", cmd.Name, taskID)
 
 	return nil
 }
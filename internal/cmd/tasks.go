@@ -2,9 +2,14 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/builtbyrobben/clickup-cli/internal/api"
 	"github.com/builtbyrobben/clickup-cli/internal/clickup"
 	"github.com/builtbyrobben/clickup-cli/internal/outfmt"
 )
@@ -12,6 +17,8 @@ import (
 type TasksCmd struct {
 	List             TasksListCmd             `cmd:"" help:"List tasks in a list"`
 	Get              TasksGetCmd              `cmd:"" help:"Get a task by ID"`
+	Edit             TasksEditCmd             `cmd:"" help:"Edit a task in $EDITOR as YAML front matter and Markdown"`
+	Tree             TasksTreeCmd             `cmd:"" help:"Show a task's subtask tree with progress"`
 	Create           TasksCreateCmd           `cmd:"" help:"Create a new task"`
 	Update           TasksUpdateCmd           `cmd:"" help:"Update a task"`
 	Delete           TasksDeleteCmd           `cmd:"" help:"Delete a task"`
@@ -21,12 +28,15 @@ type TasksCmd struct {
 	Merge            TasksMergeCmd            `cmd:"" help:"Merge tasks into one"`
 	Move             TasksMoveCmd             `cmd:"" help:"Move a task to a different list"`
 	FromTemplate     TasksFromTemplateCmd     `cmd:"" help:"Create a task from a template"`
+	Bulk             TasksBulkCmd             `cmd:"" help:"Apply one change to many tasks concurrently"`
+	Import           TasksImportCmd           `cmd:"" help:"Create tasks from a CSV or JSON file"`
 }
 
 type TasksListCmd struct {
-	List     string `required:"" help:"List ID to fetch tasks from"`
-	Status   string `help:"Filter by status (e.g. open, closed)"`
-	Assignee string `help:"Filter by assignee name or ID"`
+	List            ListArg `required:"" help:"List (ID, name, path or URL) to fetch tasks from"`
+	Status          string  `help:"Filter by status (e.g. open, closed)"`
+	Assignee        UserArg `help:"Filter by assignee (user ID, email or @username)"`
+	PaginationFlags `embed:""`
 }
 
 func (cmd *TasksListCmd) Run(ctx context.Context) error {
@@ -35,43 +45,50 @@ func (cmd *TasksListCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	result, err := client.Tasks().List(ctx, cmd.List, cmd.Status, cmd.Assignee)
+	listID, err := cmd.List.Resolve(ctx, client)
 	if err != nil {
 		return err
 	}
 
-	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+	assigneeID, err := cmd.Assignee.Resolve(ctx, client)
+	if err != nil {
+		return err
 	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "NAME", "STATUS", "PRIORITY", "URL"}
-		var rows [][]string
-		for _, task := range result.Tasks {
-			priority := ""
-			if task.Priority != nil {
-				priority = task.Priority.Name
-			}
-			rows = append(rows, []string{task.ID, task.Name, task.Status.Status, priority, task.URL})
-		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+
+	var assignee string
+	if assigneeID != 0 {
+		assignee = strconv.Itoa(assigneeID)
+	}
+
+	headers := []string{"ID", "NAME", "STATUS", "PRIORITY", "URL"}
+	row := func(task *clickup.Task) []outfmt.Cell {
+		return []outfmt.Cell{{Text: task.ID}, {Text: task.Name}, taskStatusCell(task), taskPriorityCell(task), {Text: task.URL}}
 	}
 
-	if len(result.Tasks) == 0 {
-		fmt.Fprintln(os.Stderr, "No tasks found")
-		return nil
+	if cmd.PaginationFlags.enabled() {
+		return writeTaskStream(ctx,
+			client.Tasks().Iter(ctx, listID, cmd.Status, assignee),
+			cmd.Limit,
+			headers,
+			row,
+			func(tasks []clickup.Task) any { return clickup.TasksListResponse{Tasks: tasks} },
+		)
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d tasks\n\n", len(result.Tasks))
+	result, err := client.Tasks().List(ctx, listID, cmd.Status, assignee)
+	if err != nil {
+		return err
+	}
 
-	for _, task := range result.Tasks {
-		printTask(&task)
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	return nil
+	return writeTaskRows(ctx, taskSeq(result.Tasks), 0, headers, row)
 }
 
 type TasksGetCmd struct {
-	TaskID string `arg:"" required:"" help:"Task ID"`
+	TaskID TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
 }
 
 func (cmd *TasksGetCmd) Run(ctx context.Context) error {
@@ -80,13 +97,18 @@ func (cmd *TasksGetCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	result, err := client.Tasks().Get(ctx, cmd.TaskID)
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	result, err := client.Tasks().Get(ctx, taskID, clickup.GetTaskParams{IncludeSubtasks: true, IncludeMarkdownDescription: true})
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME", "STATUS", "PRIORITY", "DUE_DATE", "URL"}
@@ -94,118 +116,386 @@ func (cmd *TasksGetCmd) Run(ctx context.Context) error {
 		if result.Priority != nil {
 			priority = result.Priority.Name
 		}
-		rows := [][]string{{result.ID, result.Name, result.Status.Status, priority, result.DueDate, result.URL}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		rows := [][]string{{result.ID, result.Name, result.Status.Status, priority, outfmt.Timestamp(ctx, result.DueDate), result.URL}}
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
-	printTaskDetail(result)
+	printTaskDetail(ctx, result)
 
 	return nil
 }
 
 type TasksCreateCmd struct {
-	ListID   string `arg:"" required:"" help:"List ID to create task in"`
-	Name     string `arg:"" required:"" help:"Task name"`
-	Assignee int    `help:"Assign to user ID"`
-	Priority *int   `help:"Priority (1=urgent, 2=high, 3=normal, 4=low)"`
-	Due      string `help:"Due date (unix timestamp in milliseconds)"`
+	ListID        ListArg       `arg:"" required:"" help:"List (ID, name, path or URL) to create task in"`
+	Name          string        `arg:"" required:"" help:"Task name"`
+	Description   string        `help:"Plain-text description"`
+	Markdown      string        `help:"Markdown description: text, @FILE, or - for stdin" placeholder:"TEXT|@FILE|-"`
+	Assignee      []UserArg     `help:"Assignee (user ID, email or @username; can be repeated)"`
+	Tag           []string      `help:"Tag name (can be repeated)"`
+	Status        string        `help:"Initial status"`
+	Priority      *int          `help:"Priority (1=urgent, 2=high, 3=normal, 4=low)"`
+	Due           DateFlag      `help:"Due date (e.g. 2026-10-20, \"2026-10-20 17:00\", tomorrow, \"next friday\", eow, +3d)" placeholder:"DATE"`
+	Start         DateFlag      `help:"Start date" placeholder:"DATE"`
+	Estimate      time.Duration `help:"Time estimate, e.g. 90m or 2h30m"`
+	Parent        TaskArg       `help:"Parent task (ID, custom ID or URL); creates a subtask"`
+	LinksTo       TaskArg       `help:"Task (ID, custom ID or URL) to link the new task to"`
+	Field         []string      `help:"Custom field value as NAME=VALUE, by field name or ID (can be repeated)" placeholder:"NAME=VALUE"`
+	NotifyAll     bool          `help:"Notify all assignees and watchers, including you"`
+	CheckRequired bool          `name:"check-required-fields" help:"Fail if required custom fields are missing"`
 }
 
 func (cmd *TasksCreateCmd) Run(ctx context.Context) error {
+	due, err := cmd.Due.Millis(ctx, "--due")
+	if err != nil {
+		return err
+	}
+
+	start, err := cmd.Start.Millis(ctx, "--start")
+	if err != nil {
+		return err
+	}
+
+	markdown, err := readText(cmd.Markdown)
+	if err != nil {
+		return err
+	}
+
 	client, err := getClickUpClient(ctx)
 	if err != nil {
 		return err
 	}
 
-	req := clickup.CreateTaskRequest{
-		Name:    cmd.Name,
-		DueDate: cmd.Due,
+	listID, err := cmd.ListID.Resolve(ctx, client)
+	if err != nil {
+		return err
 	}
 
-	if cmd.Assignee != 0 {
-		req.Assignees = []int{cmd.Assignee}
+	assignees, err := resolveUsers(ctx, client, cmd.Assignee)
+	if err != nil {
+		return err
 	}
 
-	if cmd.Priority != nil {
-		req.Priority = cmd.Priority
+	parent, err := cmd.Parent.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	linksTo, err := cmd.LinksTo.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	req := clickup.CreateTaskRequest{
+		Name:                      cmd.Name,
+		Description:               cmd.Description,
+		MarkdownDescription:       markdown,
+		Assignees:                 assignees,
+		Tags:                      cmd.Tag,
+		Status:                    cmd.Status,
+		Priority:                  cmd.Priority,
+		DueDate:                   due,
+		DueDateTime:               hasTimeOfDay(ctx, due),
+		StartDate:                 start,
+		StartDateTime:             hasTimeOfDay(ctx, start),
+		TimeEstimate:              cmd.Estimate.Milliseconds(),
+		NotifyAll:                 cmd.NotifyAll,
+		Parent:                    parent,
+		LinksTo:                   linksTo,
+		CheckRequiredCustomFields: cmd.CheckRequired,
+	}
+
+	if len(cmd.Field) > 0 {
+		fields, err := client.CustomFields().ListByList(ctx, listID)
+		if err != nil {
+			return err
+		}
+
+		req.CustomFields, err = clickup.CustomFieldValues(fields.Fields, cmd.Field)
+		if err != nil {
+			return newUsageError(err)
+		}
 	}
 
-	result, err := client.Tasks().Create(ctx, cmd.ListID, req)
+	result, err := client.Tasks().Create(ctx, listID, req)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME", "STATUS", "URL"}
 		rows := [][]string{{result.ID, result.Name, result.Status.Status, result.URL}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Created task\n\n")
-	printTaskDetail(result)
+	printTaskDetail(ctx, result)
 
 	return nil
 }
 
 type TasksUpdateCmd struct {
-	TaskID   string `arg:"" required:"" help:"Task ID"`
-	Status   string `help:"New status"`
-	Name     string `help:"New name"`
-	Assignee int    `help:"Assign to user ID (adds assignee)"`
-	Unassign int    `help:"Unassign user ID (removes assignee)"`
-	Priority *int   `help:"New priority (1=urgent, 2=high, 3=normal, 4=low)"`
+	TaskID      TaskArg       `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
+	Status      string        `help:"New status"`
+	Name        string        `help:"New name"`
+	Description string        `help:"New plain-text description"`
+	Markdown    string        `help:"New markdown description: text, @FILE, or - for stdin" placeholder:"TEXT|@FILE|-"`
+	Assignee    []UserArg     `help:"Add an assignee (user ID, email or @username; can be repeated)"`
+	Unassign    []UserArg     `help:"Remove an assignee (user ID, email or @username; can be repeated)"`
+	Priority    *int          `help:"New priority (1=urgent, 2=high, 3=normal, 4=low)"`
+	Due         DateFlag      `help:"New due date (e.g. 2026-10-20, \"2026-10-20 17:00\", tomorrow, \"next friday\", eow, +3d)" placeholder:"DATE"`
+	ClearDue    bool          `help:"Remove the due date"`
+	Start       DateFlag      `help:"New start date" placeholder:"DATE"`
+	ClearStart  bool          `help:"Remove the start date"`
+	Estimate    time.Duration `help:"New time estimate, e.g. 90m or 2h30m"`
+	Parent      TaskArg       `help:"Move under this parent task (ID, custom ID or URL)"`
+	Archived    *bool         `help:"Archive (true) or unarchive (false) the task"`
+	Field       []string      `help:"Set a custom field as NAME=VALUE, by field name or ID (can be repeated)" placeholder:"NAME=VALUE"`
+	Recursive   bool          `help:"Apply the update to all subtasks too"`
 }
 
 func (cmd *TasksUpdateCmd) Run(ctx context.Context) error {
+	if cmd.Recursive && (cmd.Name != "" || cmd.Parent != "") {
+		return newUsageError(fmt.Errorf("--name and --parent cannot be combined with --recursive"))
+	}
+
+	if cmd.ClearDue && cmd.Due != "" {
+		return newUsageError(fmt.Errorf("--due and --clear-due are mutually exclusive"))
+	}
+
+	if cmd.ClearStart && cmd.Start != "" {
+		return newUsageError(fmt.Errorf("--start and --clear-start are mutually exclusive"))
+	}
+
+	due, err := cmd.Due.Millis(ctx, "--due")
+	if err != nil {
+		return err
+	}
+
+	start, err := cmd.Start.Millis(ctx, "--start")
+	if err != nil {
+		return err
+	}
+
+	markdown, err := readText(cmd.Markdown)
+	if err != nil {
+		return err
+	}
+
 	client, err := getClickUpClient(ctx)
 	if err != nil {
 		return err
 	}
 
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	assignees, err := resolveUsers(ctx, client, cmd.Assignee)
+	if err != nil {
+		return err
+	}
+
+	unassign, err := resolveUsers(ctx, client, cmd.Unassign)
+	if err != nil {
+		return err
+	}
+
+	parent, err := cmd.Parent.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	req := clickup.UpdateTaskRequest{
-		Name:   cmd.Name,
-		Status: cmd.Status,
+		Name:                cmd.Name,
+		Description:         cmd.Description,
+		MarkdownDescription: markdown,
+		Status:              cmd.Status,
+		Priority:            cmd.Priority,
+		DueDate:             clickup.ClearableMillis{Millis: due, Clear: cmd.ClearDue},
+		DueDateTime:         hasTimeOfDay(ctx, due),
+		StartDate:           clickup.ClearableMillis{Millis: start, Clear: cmd.ClearStart},
+		StartDateTime:       hasTimeOfDay(ctx, start),
+		TimeEstimate:        cmd.Estimate.Milliseconds(),
+		Parent:              parent,
+		Archived:            cmd.Archived,
 	}
 
-	if cmd.Assignee != 0 || cmd.Unassign != 0 {
-		req.Assignees = &clickup.TaskAssigneesUpdate{}
-		if cmd.Assignee != 0 {
-			req.Assignees.Add = []int{cmd.Assignee}
+	if len(assignees) > 0 || len(unassign) > 0 {
+		req.Assignees = &clickup.TaskAssigneesUpdate{Add: assignees, Rem: unassign}
+	}
+
+	tasks := []*clickup.Task{{ID: taskID}}
+
+	var root *clickup.Task
+
+	if cmd.Recursive {
+		root, err = taskTree(ctx, client, taskID)
+		if err != nil {
+			return err
 		}
-		if cmd.Unassign != 0 {
-			req.Assignees.Rem = []int{cmd.Unassign}
+
+		tasks = nil
+		root.Walk(func(task *clickup.Task, _ int) {
+			tasks = append(tasks, task)
+		})
+	}
+
+	// Resolved before anything changes, as subtasks may be in other lists.
+	fields, err := taskFieldValues(ctx, client, tasks, cmd.Field)
+	if err != nil {
+		return err
+	}
+
+	if root != nil {
+		if err := confirmSubtree(ctx, "update", root); err != nil {
+			return err
 		}
 	}
 
-	if cmd.Priority != nil {
-		req.Priority = cmd.Priority
+	ids := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
 	}
 
-	result, err := client.Tasks().Update(ctx, cmd.TaskID, req)
+	results, err := updateTasks(ctx, client, ids, req, fields)
 	if err != nil {
 		return err
 	}
 
+	if dryRunEnabled(ctx) {
+		return api.ErrDryRun
+	}
+
+	if cmd.Recursive {
+		return writeSubtreeResults(ctx, "Updated", results)
+	}
+
+	result := results[0]
+
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME", "STATUS", "URL"}
 		rows := [][]string{{result.ID, result.Name, result.Status.Status, result.URL}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Updated task\n\n")
-	printTaskDetail(result)
+	printTaskDetail(ctx, result)
 
 	return nil
 }
 
+// updateTasks sends req to each task and sets its custom fields, returning
+// the tasks as updated. A dry run goes on past each suppressed request so
+// that all of them are printed.
+func updateTasks(ctx context.Context, client *clickup.Client, ids []string, req clickup.UpdateTaskRequest, fields map[string][]clickup.CustomFieldValue) ([]*clickup.Task, error) {
+	// With only --field given there is nothing for the update endpoint.
+	send := req != (clickup.UpdateTaskRequest{})
+
+	results := make([]*clickup.Task, 0, len(ids))
+
+	for _, id := range ids {
+		var (
+			result *clickup.Task
+			err    error
+		)
+
+		if send {
+			result, err = client.Tasks().Update(ctx, id, req)
+			if err != nil && !errors.Is(err, api.ErrDryRun) {
+				return nil, fmt.Errorf("task %s: %w", id, err)
+			}
+		}
+
+		// The update endpoint takes no custom fields; each is set on its own.
+		for _, field := range fields[id] {
+			if err := client.CustomFields().Set(ctx, id, field.ID, field.Value); err != nil && !errors.Is(err, api.ErrDryRun) {
+				return nil, fmt.Errorf("task %s: %w", id, err)
+			}
+		}
+
+		// Without an update response, fetch the task to report it.
+		if result == nil && !dryRunEnabled(ctx) {
+			result, err = client.Tasks().Get(ctx, id, clickup.GetTaskParams{})
+			if err != nil {
+				return nil, fmt.Errorf("task %s: %w", id, err)
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// taskFieldValues resolves NAME=VALUE custom field assignments for each task
+// against the fields of its own list, listing each list's fields once. Tasks
+// given without their list are fetched.
+func taskFieldValues(ctx context.Context, client *clickup.Client, tasks []*clickup.Task, assignments []string) (map[string][]clickup.CustomFieldValue, error) {
+	if len(assignments) == 0 {
+		return nil, nil
+	}
+
+	byList := map[string][]clickup.CustomFieldValue{}
+	values := make(map[string][]clickup.CustomFieldValue, len(tasks))
+
+	for _, task := range tasks {
+		listID := task.List.ID
+		if listID == "" {
+			full, err := client.Tasks().Get(ctx, task.ID, clickup.GetTaskParams{})
+			if err != nil {
+				return nil, err
+			}
+
+			listID = full.List.ID
+		}
+
+		if _, ok := byList[listID]; !ok {
+			fields, err := client.CustomFields().ListByList(ctx, listID)
+			if err != nil {
+				return nil, err
+			}
+
+			resolved, err := clickup.CustomFieldValues(fields.Fields, assignments)
+			if err != nil {
+				return nil, newUsageError(fmt.Errorf("task %s: %w", task.ID, err))
+			}
+
+			byList[listID] = resolved
+		}
+
+		values[task.ID] = byList[listID]
+	}
+
+	return values, nil
+}
+
+// hasTimeOfDay reports whether ms falls at a time other than midnight in the
+// --tz zone, so ClickUp shows the time as well as the date.
+func hasTimeOfDay(ctx context.Context, ms int64) bool {
+	if ms == 0 {
+		return false
+	}
+
+	loc := outfmt.FromContext(ctx).Time.Location
+	if loc == nil {
+		loc = time.Local
+	}
+
+	t := time.UnixMilli(ms).In(loc)
+
+	return t.Hour() != 0 || t.Minute() != 0 || t.Second() != 0
+}
+
 type TasksDeleteCmd struct {
-	TaskID string `arg:"" required:"" help:"Task ID"`
+	TaskID    TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
+	Recursive bool    `help:"Delete all subtasks too, deepest first"`
 }
 
 func (cmd *TasksDeleteCmd) Run(ctx context.Context) error {
@@ -214,96 +504,116 @@ func (cmd *TasksDeleteCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	if err := client.Tasks().Delete(ctx, cmd.TaskID); err != nil {
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Recursive {
+		return cmd.deleteSubtree(ctx, client, taskID)
+	}
+
+	if err := client.Tasks().Delete(ctx, taskID); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":  "success",
 			"message": "Task deleted",
-			"task_id": cmd.TaskID,
+			"task_id": taskID,
 		})
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "TASK_ID"}
-		rows := [][]string{{"success", cmd.TaskID}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		rows := [][]string{{"success", taskID}}
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
-	fmt.Fprintf(os.Stderr, "Task %s deleted\n", cmd.TaskID)
+	fmt.Fprintf(os.Stderr, "Task %s deleted\n", taskID)
 
 	return nil
 }
 
 // TasksSearchCmd searches for tasks across a workspace.
 type TasksSearchCmd struct {
-	TeamID        string   `required:"" help:"Team ID to search within"`
-	Status        []string `help:"Filter by status (can be repeated)"`
-	Assignee      []int    `help:"Filter by assignee user ID (can be repeated)"`
-	Tag           []string `help:"Filter by tag (can be repeated)"`
-	DueDateGt     int64    `help:"Due date greater than (unix ms)"`
-	DueDateLt     int64    `help:"Due date less than (unix ms)"`
-	IncludeClosed bool     `help:"Include closed tasks"`
-	Page          int      `help:"Page number (0-indexed)"`
-	OrderBy       string   `help:"Order by field (e.g. due_date, created)"`
+	TeamID        string    `required:"" help:"Team ID to search within"`
+	Status        []string  `help:"Filter by status (can be repeated)"`
+	Assignee      []UserArg `help:"Filter by assignee (user ID, email or @username; can be repeated)"`
+	Tag           []string  `help:"Filter by tag (can be repeated)"`
+	DueDateGt     DateFlag  `help:"Due date after (e.g. 2026-10-20, \"2026-10-20 17:00\", tomorrow, \"next friday\", eow, +3d)" placeholder:"DATE"`
+	DueDateLt     DateFlag  `help:"Due date before" placeholder:"DATE"`
+	IncludeClosed bool      `help:"Include closed tasks"`
+	Page          int       `help:"Page number (0-indexed)"`
+	OrderBy       string    `help:"Order by field (e.g. due_date, created)"`
+
+	PaginationFlags `embed:""`
 }
 
 func (cmd *TasksSearchCmd) Run(ctx context.Context) error {
+	dueDateGt, err := cmd.DueDateGt.Millis(ctx, "--due-date-gt")
+	if err != nil {
+		return err
+	}
+
+	dueDateLt, err := cmd.DueDateLt.Millis(ctx, "--due-date-lt")
+	if err != nil {
+		return err
+	}
+
 	client, err := getClickUpClient(ctx)
 	if err != nil {
 		return err
 	}
 
+	assignees, err := resolveUsers(ctx, client, cmd.Assignee)
+	if err != nil {
+		return err
+	}
+
 	params := clickup.FilteredTeamTasksParams{
 		Page:          cmd.Page,
 		OrderBy:       cmd.OrderBy,
 		Statuses:      cmd.Status,
-		Assignees:     cmd.Assignee,
+		Assignees:     assignees,
 		Tags:          cmd.Tag,
-		DueDateGt:     cmd.DueDateGt,
-		DueDateLt:     cmd.DueDateLt,
+		DueDateGt:     dueDateGt,
+		DueDateLt:     dueDateLt,
 		IncludeClosed: cmd.IncludeClosed,
 	}
 
-	result, err := client.Tasks().Search(ctx, cmd.TeamID, params)
-	if err != nil {
-		return err
-	}
-
-	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
-	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "NAME", "STATUS", "PRIORITY", "LIST", "URL"}
-		rows := make([][]string, 0, len(result.Tasks))
-		for _, task := range result.Tasks {
-			priority := ""
-			if task.Priority != nil {
-				priority = task.Priority.Name
-			}
-			rows = append(rows, []string{task.ID, task.Name, task.Status.Status, priority, task.List.Name, task.URL})
+	headers := []string{"ID", "NAME", "STATUS", "PRIORITY", "LIST", "URL"}
+	row := func(task *clickup.Task) []outfmt.Cell {
+		return []outfmt.Cell{
+			{Text: task.ID}, {Text: task.Name}, taskStatusCell(task), taskPriorityCell(task), {Text: task.List.Name}, {Text: task.URL},
 		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
 	}
 
-	if len(result.Tasks) == 0 {
-		fmt.Fprintln(os.Stderr, "No tasks found")
-		return nil
+	if cmd.PaginationFlags.enabled() {
+		return writeTaskStream(ctx,
+			client.Tasks().SearchIter(ctx, cmd.TeamID, params),
+			cmd.Limit,
+			headers,
+			row,
+			func(tasks []clickup.Task) any { return clickup.FilteredTeamTasksResponse{Tasks: tasks} },
+		)
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d tasks\n\n", len(result.Tasks))
+	result, err := client.Tasks().Search(ctx, cmd.TeamID, params)
+	if err != nil {
+		return err
+	}
 
-	for _, task := range result.Tasks {
-		printTask(&task)
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	return nil
+	return writeTaskRows(ctx, taskSeq(result.Tasks), 0, headers, row)
 }
 
 // TasksTimeInStatusCmd gets time-in-status for a single task.
 type TasksTimeInStatusCmd struct {
-	TaskID string `arg:"" required:"" help:"Task ID"`
+	TaskID TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
 }
 
 func (cmd *TasksTimeInStatusCmd) Run(ctx context.Context) error {
@@ -312,13 +622,18 @@ func (cmd *TasksTimeInStatusCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	result, err := client.Tasks().TimeInStatus(ctx, cmd.TaskID)
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	result, err := client.Tasks().TimeInStatus(ctx, taskID)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "MINUTES", "CURRENT"}
@@ -332,10 +647,10 @@ func (cmd *TasksTimeInStatusCmd) Run(ctx context.Context) error {
 			rows = append(rows, []string{result.CurrentStatus.Status, formatMinutes(result.CurrentStatus.TotalTime.ByMinute), "true"})
 		}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
-	fmt.Fprintf(os.Stderr, "Time in Status for task %s\n\n", cmd.TaskID)
+	fmt.Fprintf(os.Stderr, "Time in Status for task %s\n\n", taskID)
 
 	for _, s := range result.StatusHistory {
 		fmt.Printf("  %s: %s\n", s.Status, formatMinutesToDuration(s.TotalTime.ByMinute))
@@ -350,7 +665,7 @@ func (cmd *TasksTimeInStatusCmd) Run(ctx context.Context) error {
 
 // TasksBulkTimeInStatusCmd gets time-in-status for multiple tasks.
 type TasksBulkTimeInStatusCmd struct {
-	TaskIDs []string `arg:"" required:"" help:"Task IDs"`
+	TaskIDs []TaskArg `arg:"" required:"" help:"Task IDs, custom IDs or URLs"`
 }
 
 func (cmd *TasksBulkTimeInStatusCmd) Run(ctx context.Context) error {
@@ -359,13 +674,18 @@ func (cmd *TasksBulkTimeInStatusCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	result, err := client.Tasks().BulkTimeInStatus(ctx, cmd.TaskIDs)
+	taskIDs, err := resolveTasks(ctx, client, cmd.TaskIDs)
+	if err != nil {
+		return err
+	}
+
+	result, err := client.Tasks().BulkTimeInStatus(ctx, taskIDs)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"TASK_ID", "STATUS", "MINUTES", "CURRENT"}
@@ -381,7 +701,7 @@ func (cmd *TasksBulkTimeInStatusCmd) Run(ctx context.Context) error {
 			}
 		}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	for taskID, data := range result {
@@ -403,8 +723,8 @@ func (cmd *TasksBulkTimeInStatusCmd) Run(ctx context.Context) error {
 
 // TasksMergeCmd merges tasks into one.
 type TasksMergeCmd struct {
-	TargetTaskID  string   `arg:"" required:"" help:"Target task ID to merge into"`
-	SourceTaskIDs []string `required:"" help:"Source task IDs to merge (comma-separated or repeated --source)"`
+	TargetTaskID  TaskArg   `arg:"" required:"" help:"Target task (ID, custom ID or URL) to merge into"`
+	SourceTaskIDs []TaskArg `required:"" help:"Source task IDs, custom IDs or URLs to merge (comma-separated or repeated --source)"`
 }
 
 func (cmd *TasksMergeCmd) Run(ctx context.Context) error {
@@ -413,27 +733,37 @@ func (cmd *TasksMergeCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	if len(cmd.SourceTaskIDs) == 0 {
+	targetTaskID, err := cmd.TargetTaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	sourceTaskIDs, err := resolveTasks(ctx, client, cmd.SourceTaskIDs)
+	if err != nil {
+		return err
+	}
+
+	if len(sourceTaskIDs) == 0 {
 		return fmt.Errorf("at least one source task ID is required")
 	}
 
-	result, err := client.Tasks().Merge(ctx, cmd.TargetTaskID, cmd.SourceTaskIDs)
+	result, err := client.Tasks().Merge(ctx, targetTaskID, sourceTaskIDs)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":      "success",
 			"message":     "Tasks merged",
-			"target_id":   cmd.TargetTaskID,
+			"target_id":   targetTaskID,
 			"merged_into": result.ID,
 		})
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "TARGET_ID", "MERGED_INTO"}
-		rows := [][]string{{"success", cmd.TargetTaskID, result.ID}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		rows := [][]string{{"success", targetTaskID, result.ID}}
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Tasks merged into %s\n", result.ID)
@@ -443,8 +773,9 @@ func (cmd *TasksMergeCmd) Run(ctx context.Context) error {
 
 // TasksMoveCmd moves a task to a different list.
 type TasksMoveCmd struct {
-	TaskID string `arg:"" required:"" help:"Task ID to move"`
-	ListID string `required:"" help:"Target list ID"`
+	TaskID    TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL) to move"`
+	ListID    ListArg `required:"" help:"Target list (ID, name, path or URL)"`
+	Recursive bool    `help:"Move all subtasks too"`
 }
 
 func (cmd *TasksMoveCmd) Run(ctx context.Context) error {
@@ -453,30 +784,44 @@ func (cmd *TasksMoveCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	result, err := client.Tasks().Move(ctx, cmd.TaskID, cmd.ListID)
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	listID, err := cmd.ListID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Recursive {
+		return cmd.moveSubtree(ctx, client, taskID, listID)
+	}
+
+	result, err := client.Tasks().Move(ctx, taskID, listID)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "TASK_ID", "LIST_ID"}
 		rows := [][]string{{result.Status, result.TaskID, result.ListID}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
-	fmt.Fprintf(os.Stderr, "Task %s moved to list %s\n", cmd.TaskID, cmd.ListID)
+	fmt.Fprintf(os.Stderr, "Task %s moved to list %s\n", taskID, listID)
 
 	return nil
 }
 
 // TasksFromTemplateCmd creates a task from a template.
 type TasksFromTemplateCmd struct {
-	ListID     string `arg:"" required:"" help:"List ID to create task in"`
-	TemplateID string `arg:"" required:"" help:"Template ID"`
-	Name       string `help:"Override task name"`
+	ListID     ListArg `arg:"" required:"" help:"List (ID, name, path or URL) to create task in"`
+	TemplateID string  `arg:"" required:"" help:"Template ID"`
+	Name       string  `help:"Override task name"`
 }
 
 func (cmd *TasksFromTemplateCmd) Run(ctx context.Context) error {
@@ -485,26 +830,31 @@ func (cmd *TasksFromTemplateCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	listID, err := cmd.ListID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	req := clickup.CreateTaskFromTemplateRequest{
 		Name: cmd.Name,
 	}
 
-	result, err := client.Tasks().CreateFromTemplate(ctx, cmd.ListID, cmd.TemplateID, req)
+	result, err := client.Tasks().CreateFromTemplate(ctx, listID, cmd.TemplateID, req)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME", "STATUS", "URL"}
 		rows := [][]string{{result.ID, result.Name, result.Status.Status, result.URL}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Created task from template\n\n")
-	printTaskDetail(result)
+	printTaskDetail(ctx, result)
 
 	return nil
 }
@@ -522,24 +872,29 @@ func formatMinutesToDuration(minutes int64) string {
 	return fmt.Sprintf("%dh %dm", hours, mins)
 }
 
-func printTask(task *clickup.Task) {
-	fmt.Printf("ID: %s\n", task.ID)
-	fmt.Printf("  Name: %s\n", task.Name)
-	fmt.Printf("  Status: %s\n", task.Status.Status)
-
-	if task.Priority != nil {
-		fmt.Printf("  Priority: %s\n", task.Priority.Name)
-	}
+// taskStatusCell is the task's status, coloured with its ClickUp colour in
+// table output.
+func taskStatusCell(task *clickup.Task) outfmt.Cell {
+	return outfmt.Cell{Text: task.Status.Status, Color: task.Status.Color}
+}
 
-	if task.URL != "" {
-		fmt.Printf("  URL: %s\n", task.URL)
+// taskPriorityCell is the task's priority, coloured with its ClickUp colour in
+// table output.
+func taskPriorityCell(task *clickup.Task) outfmt.Cell {
+	if task.Priority == nil {
+		return outfmt.Cell{}
 	}
 
-	fmt.Println()
+	return outfmt.Cell{Text: task.Priority.Name, Color: task.Priority.Color}
 }
 
-func printTaskDetail(task *clickup.Task) {
+func printTaskDetail(ctx context.Context, task *clickup.Task) {
 	fmt.Printf("ID: %s\n", task.ID)
+
+	if task.CustomID != "" {
+		fmt.Printf("Custom ID: %s\n", task.CustomID)
+	}
+
 	fmt.Printf("Name: %s\n", task.Name)
 	fmt.Printf("Status: %s\n", task.Status.Status)
 
@@ -547,25 +902,201 @@ func printTaskDetail(task *clickup.Task) {
 		fmt.Printf("Priority: %s\n", task.Priority.Name)
 	}
 
-	if task.Description != "" {
-		fmt.Printf("Description: %s\n", task.Description)
+	if location := taskLocation(task); location != "" {
+		fmt.Printf("Location: %s\n", location)
 	}
 
-	if task.DueDate != "" {
-		fmt.Printf("Due Date: %s\n", task.DueDate)
+	if task.Creator != nil {
+		fmt.Printf("Creator: %s\n", task.Creator.Username)
 	}
 
 	if len(task.Assignees) > 0 {
-		fmt.Print("Assignees:")
+		fmt.Printf("Assignees: %s\n", usernames(task.Assignees))
+	}
+
+	if len(task.Watchers) > 0 {
+		fmt.Printf("Watchers: %s\n", usernames(task.Watchers))
+	}
+
+	for _, d := range []struct{ label, value string }{
+		{"Start Date", task.StartDate},
+		{"Due Date", task.DueDate},
+		{"Created", task.DateCreated},
+		{"Updated", task.DateUpdated},
+		{"Closed", task.DateClosed},
+		{"Done", task.DateDone},
+	} {
+		if d.value != "" {
+			fmt.Printf("%s: %s\n", d.label, outfmt.Timestamp(ctx, d.value))
+		}
+	}
 
-		for _, a := range task.Assignees {
-			fmt.Printf(" %s", a.Username)
+	if task.TimeEstimate > 0 {
+		fmt.Printf("Time Estimate: %s\n", formatDuration(task.TimeEstimate))
+	}
+
+	if task.TimeSpent > 0 {
+		fmt.Printf("Time Spent: %s\n", formatDuration(task.TimeSpent))
+	}
+
+	if task.Points != nil {
+		fmt.Printf("Points: %s\n", strconv.FormatFloat(*task.Points, 'f', -1, 64))
+	}
+
+	if len(task.Tags) > 0 {
+		tags := make([]string, 0, len(task.Tags))
+		for _, tag := range task.Tags {
+			tags = append(tags, tag.Name)
 		}
 
-		fmt.Println()
+		fmt.Printf("Tags: %s\n", strings.Join(tags, ", "))
+	}
+
+	if task.Parent != "" {
+		fmt.Printf("Parent: %s\n", task.Parent)
+	}
+
+	if task.Archived {
+		fmt.Println("Archived: yes")
 	}
 
 	if task.URL != "" {
 		fmt.Printf("URL: %s\n", task.URL)
 	}
+
+	switch {
+	case task.MarkdownDescription != "":
+		fmt.Printf("\nDescription:\n%s\n", strings.TrimRight(task.MarkdownDescription, "\n"))
+	case task.Description != "":
+		fmt.Printf("\nDescription:\n%s\n", strings.TrimRight(task.Description, "\n"))
+	}
+
+	printTaskRelations(task)
+	printTaskChecklists(task.Checklists)
+	printTaskCustomFields(ctx, task.CustomFields)
+
+	if len(task.Subtasks) > 0 {
+		fmt.Printf("\nSubtasks (%d):\n", len(task.Subtasks))
+
+		for _, sub := range task.Subtasks {
+			line := fmt.Sprintf("  %s  %s [%s]", sub.ID, sub.Name, sub.Status.Status)
+			if len(sub.Assignees) > 0 {
+				line += " " + usernames(sub.Assignees)
+			}
+
+			fmt.Println(line)
+		}
+	}
+}
+
+// taskLocation renders where a task lives as "Space / Folder / List",
+// skipping hidden folders and parts the API did not name.
+func taskLocation(task *clickup.Task) string {
+	var parts []string
+
+	for _, name := range []string{task.Space.Name, task.Folder.Name, task.List.Name} {
+		if name != "" && name != "hidden" {
+			parts = append(parts, name)
+		}
+	}
+
+	return strings.Join(parts, " / ")
+}
+
+func usernames(users []clickup.User) string {
+	names := make([]string, 0, len(users))
+	for _, u := range users {
+		names = append(names, u.Username)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// printTaskRelations prints the tasks this one waits on, the tasks waiting on
+// it and its links.
+func printTaskRelations(task *clickup.Task) {
+	var blockedBy, blocking []string
+
+	for _, d := range task.Dependencies {
+		switch task.ID {
+		case d.TaskID:
+			blockedBy = append(blockedBy, d.DependsOn)
+		case d.DependsOn:
+			blocking = append(blocking, d.TaskID)
+		}
+	}
+
+	links := make([]string, 0, len(task.LinkedTasks))
+	for _, l := range task.LinkedTasks {
+		if l.TaskID == task.ID {
+			links = append(links, l.LinkID)
+		} else {
+			links = append(links, l.TaskID)
+		}
+	}
+
+	if len(blockedBy)+len(blocking)+len(links) == 0 {
+		return
+	}
+
+	fmt.Println()
+
+	if len(blockedBy) > 0 {
+		fmt.Printf("Blocked By: %s\n", strings.Join(blockedBy, ", "))
+	}
+
+	if len(blocking) > 0 {
+		fmt.Printf("Blocking: %s\n", strings.Join(blocking, ", "))
+	}
+
+	if len(links) > 0 {
+		fmt.Printf("Linked: %s\n", strings.Join(links, ", "))
+	}
+}
+
+func printTaskChecklists(checklists []clickup.Checklist) {
+	for _, c := range checklists {
+		resolved := 0
+
+		for _, item := range c.Items {
+			if item.Resolved {
+				resolved++
+			}
+		}
+
+		fmt.Printf("\nChecklist: %s (%d/%d)\n", c.Name, resolved, len(c.Items))
+
+		for _, item := range c.Items {
+			mark := " "
+			if item.Resolved {
+				mark = "x"
+			}
+
+			fmt.Printf("  [%s] %s\n", mark, item.Name)
+		}
+	}
+}
+
+// printTaskCustomFields prints the custom fields that have a value.
+func printTaskCustomFields(ctx context.Context, fields []clickup.TaskCustomField) {
+	printed := false
+
+	for _, f := range fields {
+		value := f.Text()
+		if value == "" {
+			continue
+		}
+
+		if f.Type == "date" {
+			value = outfmt.Timestamp(ctx, value)
+		}
+
+		if !printed {
+			fmt.Println("\nCustom Fields:")
+
+			printed = true
+		}
+
+		fmt.Printf("  %s: %s\n", f.Name, value)
+	}
 }
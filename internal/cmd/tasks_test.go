@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/builtbyrobben/clickup-cli/internal/api"
+	"github.com/builtbyrobben/clickup-cli/internal/clickup"
+)
+
+func TestUpdateTasks_FieldsOnlySkipsUpdate(t *testing.T) {
+	t.Parallel()
+
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"t1","name":"Task"}`))
+	}))
+	defer server.Close()
+
+	client := clickup.NewClient("key", clickup.WithAPIOptions(api.WithBaseURL(server.URL)))
+	fields := map[string][]clickup.CustomFieldValue{"t1": {{ID: "f1", Value: "5"}}}
+
+	results, err := updateTasks(context.Background(), client, []string{"t1"}, clickup.UpdateTaskRequest{}, fields)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"POST /v2/task/t1/field/f1", "GET /v2/task/t1"}
+	if !slices.Equal(requests, want) {
+		t.Fatalf("expected %v, got %v", want, requests)
+	}
+
+	if len(results) != 1 || results[0].Name != "Task" {
+		t.Fatalf("expected the fetched task to be reported, got %+v", results)
+	}
+
+	requests = nil
+
+	if _, err := updateTasks(context.Background(), client, []string{"t1"}, clickup.UpdateTaskRequest{Name: "New"}, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := []string{"PUT /v2/task/t1"}; !slices.Equal(requests, want) {
+		t.Fatalf("expected %v, got %v", want, requests)
+	}
+}
+
+func TestTaskFieldValues_ResolvesPerList(t *testing.T) {
+	t.Parallel()
+
+	var requests []string
+
+	responses := map[string]string{
+		"/v2/task/t4":       `{"id":"t4","list":{"id":"L2"}}`,
+		"/v2/list/L1/field": `{"fields":[{"id":"f1","name":"Points","type":"number"}]}`,
+		"/v2/list/L2/field": `{"fields":[{"id":"f2","name":"Points","type":"number"}]}`,
+		"/v2/list/L3/field": `{"fields":[]}`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(responses[r.URL.Path]))
+	}))
+	defer server.Close()
+
+	client := clickup.NewClient("key", clickup.WithAPIOptions(api.WithBaseURL(server.URL)))
+	ctx := context.Background()
+
+	tasks := []*clickup.Task{
+		{ID: "t1", List: clickup.ListRef{ID: "L1"}},
+		{ID: "t2", List: clickup.ListRef{ID: "L2"}},
+		{ID: "t3", List: clickup.ListRef{ID: "L1"}},
+		{ID: "t4"},
+	}
+
+	values, err := taskFieldValues(ctx, client, tasks, []string{"Points=3"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for id, want := range map[string]string{"t1": "f1", "t2": "f2", "t3": "f1", "t4": "f2"} {
+		if got := values[id]; len(got) != 1 || got[0].ID != want {
+			t.Errorf("task %s: expected field %s, got %+v", id, want, got)
+		}
+	}
+
+	if want := []string{"/v2/list/L1/field", "/v2/list/L2/field", "/v2/task/t4"}; !slices.Equal(requests, want) {
+		t.Fatalf("expected each list's fields to be listed once, got %v", requests)
+	}
+
+	tasks = append(tasks, &clickup.Task{ID: "t5", List: clickup.ListRef{ID: "L3"}})
+
+	if _, err := taskFieldValues(ctx, client, tasks, []string{"Points=3"}); err == nil || !strings.Contains(err.Error(), "task t5") {
+		t.Fatalf("expected an error naming the task whose list lacks the field, got %v", err)
+	}
+}
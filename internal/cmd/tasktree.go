@@ -0,0 +1,251 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/builtbyrobben/clickup-cli/internal/api"
+	"github.com/builtbyrobben/clickup-cli/internal/clickup"
+	"github.com/builtbyrobben/clickup-cli/internal/outfmt"
+)
+
+// TasksTreeCmd shows a task's subtask hierarchy.
+type TasksTreeCmd struct {
+	TaskID TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
+}
+
+func (cmd *TasksTreeCmd) Run(ctx context.Context) error {
+	client, err := getClickUpClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	root, err := taskTree(ctx, client, taskID)
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(ctx, os.Stdout, root)
+	}
+	if outfmt.IsPlain(ctx) {
+		headers := []string{"ID", "PARENT", "DEPTH", "NAME", "STATUS", "ASSIGNEES", "DONE", "TOTAL"}
+
+		var rows [][]string
+
+		root.Walk(func(task *clickup.Task, depth int) {
+			done, total := task.Progress()
+			rows = append(rows, []string{
+				task.ID, task.Parent, strconv.Itoa(depth), task.Name, task.Status.Status,
+				usernames(task.Assignees), strconv.Itoa(done), strconv.Itoa(total),
+			})
+		})
+
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
+	}
+
+	fmt.Println(treeLine(ctx, root))
+	printTreeBranches(ctx, root.Subtasks, "")
+
+	return nil
+}
+
+// taskTree fetches a task with all of its subtasks nested below it.
+func taskTree(ctx context.Context, client *clickup.Client, taskID string) (*clickup.Task, error) {
+	teamID, err := getTeamID()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Tasks().Tree(ctx, teamID, taskID)
+}
+
+func printTreeBranches(ctx context.Context, tasks []clickup.Task, indent string) {
+	for i := range tasks {
+		branch, next := "├── ", "│   "
+		if i == len(tasks)-1 {
+			branch, next = "└── ", "    "
+		}
+
+		fmt.Println(indent + branch + treeLine(ctx, &tasks[i]))
+		printTreeBranches(ctx, tasks[i].Subtasks, indent+next)
+	}
+}
+
+// treeLine renders one task of the tree with its status, assignees and, for
+// tasks with subtasks, how many of them are done.
+func treeLine(ctx context.Context, task *clickup.Task) string {
+	status := task.Status.Status
+	if outfmt.FromContext(ctx).Color {
+		status = outfmt.Colorize(status, task.Status.Color)
+	}
+
+	line := fmt.Sprintf("%s  %s [%s]", task.ID, task.Name, status)
+	if len(task.Assignees) > 0 {
+		line += "  " + usernames(task.Assignees)
+	}
+
+	if done, total := task.Progress(); total > 0 {
+		line += fmt.Sprintf("  %d/%d done (%d%%)", done, total, done*100/total)
+	}
+
+	return line
+}
+
+// subtreeIDs lists the IDs of a task and all of its subtasks, parents first.
+func subtreeIDs(root *clickup.Task) []string {
+	var ids []string
+
+	root.Walk(func(task *clickup.Task, _ int) {
+		ids = append(ids, task.ID)
+	})
+
+	return ids
+}
+
+// confirmSubtree lists every task a recursive command would touch and stops
+// there unless --force is set.
+func confirmSubtree(ctx context.Context, action string, root *clickup.Task) error {
+	if forceEnabled(ctx) {
+		return nil
+	}
+
+	_, total := root.Progress()
+	fmt.Fprintf(os.Stderr, "This will %s %d tasks:\n", action, total+1)
+
+	root.Walk(func(task *clickup.Task, depth int) {
+		fmt.Fprintf(os.Stderr, "  %s%s  %s\n", strings.Repeat("  ", depth), task.ID, task.Name)
+	})
+
+	fmt.Fprint(os.Stderr, "\nUse --force to confirm\n")
+
+	return fmt.Errorf("operation cancelled: use --force to confirm")
+}
+
+// writeSubtreeResults reports the tasks changed by a recursive command.
+func writeSubtreeResults(ctx context.Context, verb string, results []*clickup.Task) error {
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(ctx, os.Stdout, results)
+	}
+
+	if outfmt.IsPlain(ctx) {
+		headers := []string{"ID", "NAME", "STATUS", "URL"}
+		rows := make([][]string, 0, len(results))
+
+		for _, task := range results {
+			rows = append(rows, []string{task.ID, task.Name, task.Status.Status, task.URL})
+		}
+
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
+	}
+
+	fmt.Fprintf(os.Stderr, "%s %d tasks\n", verb, len(results))
+
+	for _, task := range results {
+		fmt.Printf("%s  %s [%s]\n", task.ID, task.Name, task.Status.Status)
+	}
+
+	return nil
+}
+
+// deleteSubtree deletes a task and its subtasks, children before their
+// parents, so that a failure never leaves orphaned subtasks behind.
+func (cmd *TasksDeleteCmd) deleteSubtree(ctx context.Context, client *clickup.Client, taskID string) error {
+	root, err := taskTree(ctx, client, taskID)
+	if err != nil {
+		return err
+	}
+
+	if err := confirmSubtree(ctx, "delete", root); err != nil {
+		return err
+	}
+
+	ids := subtreeIDs(root)
+	slices.Reverse(ids)
+
+	for _, id := range ids {
+		if err := client.Tasks().Delete(ctx, id); err != nil && !errors.Is(err, api.ErrDryRun) {
+			return fmt.Errorf("task %s: %w", id, err)
+		}
+	}
+
+	if dryRunEnabled(ctx) {
+		return api.ErrDryRun
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]any{
+			"status":   "success",
+			"message":  "Tasks deleted",
+			"task_ids": ids,
+		})
+	}
+	if outfmt.IsPlain(ctx) {
+		headers := []string{"STATUS", "TASK_ID"}
+		rows := make([][]string, 0, len(ids))
+
+		for _, id := range ids {
+			rows = append(rows, []string{"success", id})
+		}
+
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
+	}
+
+	fmt.Fprintf(os.Stderr, "Deleted %d tasks\n", len(ids))
+
+	return nil
+}
+
+// moveSubtree moves a task to another list. ClickUp takes the subtasks along
+// with their parent, so only the root is moved; moving each subtask as well
+// would re-home it independently of its parent. The subtasks are reported.
+func (cmd *TasksMoveCmd) moveSubtree(ctx context.Context, client *clickup.Client, taskID, listID string) error {
+	root, err := taskTree(ctx, client, taskID)
+	if err != nil {
+		return err
+	}
+
+	if err := confirmSubtree(ctx, "move", root); err != nil {
+		return err
+	}
+
+	result, err := client.Tasks().Move(ctx, root.ID, listID)
+	if err != nil {
+		return err
+	}
+
+	ids := subtreeIDs(root)
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]any{
+			"status":   result.Status,
+			"task_id":  result.TaskID,
+			"list_id":  result.ListID,
+			"subtasks": ids[1:],
+		})
+	}
+	if outfmt.IsPlain(ctx) {
+		headers := []string{"STATUS", "TASK_ID", "LIST_ID"}
+		rows := make([][]string, 0, len(ids))
+
+		for _, id := range ids {
+			rows = append(rows, []string{result.Status, id, listID})
+		}
+
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
+	}
+
+	fmt.Fprintf(os.Stderr, "Moved task %s and %d subtasks to list %s\n", root.ID, len(ids)-1, listID)
+
+	return nil
+}
@@ -14,8 +14,9 @@ type TemplatesCmd struct {
 }
 
 type TemplatesListCmd struct {
-	TeamID string `arg:"" required:"" help:"Team (workspace) ID"`
-	Page   int    `help:"Page number (0-indexed)" default:"0"`
+	TeamID          string `arg:"" required:"" help:"Team (workspace) ID"`
+	Page            int    `help:"Page number (0-indexed)" default:"0"`
+	PaginationFlags `embed:""`
 }
 
 func (cmd *TemplatesListCmd) Run(ctx context.Context) error {
@@ -24,13 +25,17 @@ func (cmd *TemplatesListCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	if cmd.PaginationFlags.enabled() {
+		return cmd.runAll(ctx, client)
+	}
+
 	result, err := client.Templates().List(ctx, cmd.TeamID, cmd.Page)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME"}
@@ -38,7 +43,7 @@ func (cmd *TemplatesListCmd) Run(ctx context.Context) error {
 		for _, template := range result.Templates {
 			rows = append(rows, []string{template.ID, template.Name})
 		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	// Human-readable output
@@ -47,6 +52,46 @@ func (cmd *TemplatesListCmd) Run(ctx context.Context) error {
 	return nil
 }
 
+// runAll streams every template page in the active output mode.
+func (cmd *TemplatesListCmd) runAll(ctx context.Context, client *clickup.Client) error {
+	seq := client.Templates().Iter(ctx, cmd.TeamID)
+
+	if outfmt.IsJSON(ctx) {
+		return writeJSONStream(ctx, seq, cmd.Limit, func(templates []clickup.TaskTemplate) any {
+			return clickup.TaskTemplatesResponse{Templates: templates}
+		})
+	}
+
+	if outfmt.IsPlain(ctx) {
+		if err := outfmt.WritePlain(ctx, os.Stdout, []string{"ID", "NAME"}, nil); err != nil {
+			return err
+		}
+
+		_, err := streamPages(seq, cmd.Limit, func(template clickup.TaskTemplate) error {
+			return outfmt.WritePlain(ctx, os.Stdout, nil, [][]string{{template.ID, template.Name}})
+		})
+
+		return err
+	}
+
+	fmt.Fprintln(os.Stderr, "Task Templates")
+	fmt.Fprintln(os.Stderr)
+
+	n, err := streamPages(seq, cmd.Limit, func(template clickup.TaskTemplate) error {
+		fmt.Fprintf(os.Stderr, "  %s: %s\n", template.ID, template.Name)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		fmt.Fprintln(os.Stderr, "No templates found")
+	}
+
+	return nil
+}
+
 func printTemplates(result *clickup.TaskTemplatesResponse) {
 	fmt.Fprintln(os.Stderr, "Task Templates")
 	fmt.Fprintln(os.Stderr)
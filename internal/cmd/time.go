@@ -29,45 +29,45 @@ type TimeCmd struct {
 }
 
 type TimeLogCmd struct {
-	TaskID     string `arg:"" required:"" help:"Task ID"`
-	DurationMs int64  `arg:"" required:"" help:"Duration in milliseconds"`
-	Start      string `help:"Start time as Unix ms timestamp, or 'now' (default: now)" default:"now"`
+	TaskID     TaskArg  `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
+	DurationMs int64    `arg:"" required:"" help:"Duration in milliseconds"`
+	Start      DateFlag `help:"Start time (e.g. 2026-10-20, \"2026-10-20 17:00\", tomorrow, \"next friday\", eow, +3d)" default:"now" placeholder:"DATE"`
 }
 
 func (cmd *TimeLogCmd) Run(ctx context.Context) error {
+	startMs, err := cmd.Start.Millis(ctx, "--start")
+	if err != nil {
+		return err
+	}
+
 	client, err := getClickUpClient(ctx)
 	if err != nil {
 		return err
 	}
 
-	teamID, err := getTeamID()
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
 	if err != nil {
 		return err
 	}
 
-	var startMs int64
-	if cmd.Start == "" || cmd.Start == "now" {
-		startMs = time.Now().UnixMilli()
-	} else {
-		startMs, err = strconv.ParseInt(cmd.Start, 10, 64)
-		if err != nil {
-			return fmt.Errorf("invalid start timestamp %q: %w", cmd.Start, err)
-		}
+	teamID, err := getTeamID()
+	if err != nil {
+		return err
 	}
 
-	result, err := client.Time().Log(ctx, teamID, cmd.TaskID, cmd.DurationMs, startMs)
+	result, err := client.Time().Log(ctx, teamID, taskID, cmd.DurationMs, startMs)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "DURATION", "START", "END"}
-		rows := [][]string{{result.ID.String(), result.Duration.String(), result.Start.String(), result.End.String()}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		rows := [][]string{{result.ID.String(), result.Duration.String(), outfmt.Timestamp(ctx, result.Start), outfmt.Timestamp(ctx, result.End)}}
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Time logged (ID: %s)\n", result.ID)
@@ -77,7 +77,7 @@ func (cmd *TimeLogCmd) Run(ctx context.Context) error {
 }
 
 type TimeListCmd struct {
-	TaskID string `arg:"" required:"" help:"Task ID"`
+	TaskID TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
 }
 
 func (cmd *TimeListCmd) Run(ctx context.Context) error {
@@ -86,53 +86,36 @@ func (cmd *TimeListCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	teamID, err := getTeamID()
 	if err != nil {
 		return err
 	}
 
-	result, err := client.Time().List(ctx, teamID, cmd.TaskID)
+	result, err := client.Time().List(ctx, teamID, taskID)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "DURATION", "START", "END"}
-		var rows [][]string
-		for _, entry := range result.Data {
-			rows = append(rows, []string{entry.ID.String(), entry.Duration.String(), entry.Start.String(), entry.End.String()})
-		}
-
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+	headers := []string{"ID", "DURATION", "START", "END"}
+	var rows [][]string
+	for _, entry := range result.Data {
+		rows = append(rows, []string{entry.ID.String(), entry.Duration.String(), outfmt.Timestamp(ctx, entry.Start), outfmt.Timestamp(ctx, entry.End)})
 	}
 
-	if len(result.Data) == 0 {
-		fmt.Fprintln(os.Stderr, "No time entries found")
+	if !reportRows(ctx, len(rows), "time entries") {
 		return nil
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d time entries\n\n", len(result.Data))
-
-	for _, entry := range result.Data {
-		fmt.Printf("ID: %s\n", entry.ID)
-		fmt.Printf("  Duration: %s ms\n", entry.Duration)
-
-		if entry.Start != "" {
-			fmt.Printf("  Start: %s\n", entry.Start)
-		}
-
-		if entry.End != "" {
-			fmt.Printf("  End: %s\n", entry.End)
-		}
-
-		fmt.Println()
-	}
-
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
 type TimeGetCmd struct {
@@ -156,7 +139,7 @@ func (cmd *TimeGetCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
@@ -165,13 +148,13 @@ func (cmd *TimeGetCmd) Run(ctx context.Context) error {
 			result.ID.String(),
 			result.Task.ID,
 			result.User.Username,
-			result.Start.String(),
-			result.End.String(),
+			outfmt.Timestamp(ctx, result.Start),
+			outfmt.Timestamp(ctx, result.End),
 			result.Duration.String(),
 			result.Description,
 		}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Time Entry %s\n", result.ID)
@@ -182,8 +165,8 @@ func (cmd *TimeGetCmd) Run(ctx context.Context) error {
 
 	fmt.Fprintf(os.Stderr, "  User: %s\n", result.User.Username)
 	fmt.Fprintf(os.Stderr, "  Duration: %s\n", formatDurationFromString(result.Duration.String()))
-	fmt.Fprintf(os.Stderr, "  Start: %s\n", formatTimestampFromString(result.Start.String()))
-	fmt.Fprintf(os.Stderr, "  End: %s\n", formatTimestampFromString(result.End.String()))
+	fmt.Fprintf(os.Stderr, "  Start: %s\n", outfmt.Timestamp(ctx, result.Start))
+	fmt.Fprintf(os.Stderr, "  End: %s\n", outfmt.Timestamp(ctx, result.End))
 
 	if result.Description != "" {
 		fmt.Fprintf(os.Stderr, "  Description: %s\n", result.Description)
@@ -221,7 +204,7 @@ func (cmd *TimeCurrentCmd) Run(ctx context.Context) error {
 
 	if result == nil || result.ID == "" {
 		if outfmt.IsJSON(ctx) {
-			return outfmt.WriteJSON(os.Stdout, map[string]any{"running": false})
+			return outfmt.WriteJSON(ctx, os.Stdout, map[string]any{"running": false})
 		}
 
 		fmt.Fprintln(os.Stderr, "No timer running")
@@ -230,7 +213,7 @@ func (cmd *TimeCurrentCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
@@ -238,11 +221,11 @@ func (cmd *TimeCurrentCmd) Run(ctx context.Context) error {
 		rows := [][]string{{
 			result.ID.String(),
 			result.Task.ID,
-			result.Start.String(),
+			outfmt.Timestamp(ctx, result.Start),
 			result.Description,
 		}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintln(os.Stderr, "Running Timer")
@@ -252,7 +235,7 @@ func (cmd *TimeCurrentCmd) Run(ctx context.Context) error {
 		fmt.Fprintf(os.Stderr, "  Task: %s (%s)\n", result.Task.Name, result.Task.ID)
 	}
 
-	fmt.Fprintf(os.Stderr, "  Started: %s\n", formatTimestampFromString(result.Start.String()))
+	fmt.Fprintf(os.Stderr, "  Started: %s\n", outfmt.Timestamp(ctx, result.Start))
 
 	// Running entries have negative duration convention: duration = -start_timestamp
 	fmt.Fprintf(os.Stderr, "  Elapsed: %s\n", formatDurationFromString(result.Duration.String()))
@@ -265,7 +248,7 @@ func (cmd *TimeCurrentCmd) Run(ctx context.Context) error {
 }
 
 type TimeStartCmd struct {
-	TaskID      string   `help:"Task ID to associate timer with"`
+	TaskID      TaskArg  `help:"Task (ID, custom ID or URL) to associate timer with"`
 	Description string   `help:"Description for the timer"`
 	Billable    bool     `help:"Mark timer as billable"`
 	Tags        []string `help:"Tags to apply to the timer"`
@@ -277,13 +260,18 @@ func (cmd *TimeStartCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	teamID, err := getTeamID()
 	if err != nil {
 		return err
 	}
 
 	req := clickup.StartTimeEntryRequest{
-		TaskID:      cmd.TaskID,
+		TaskID:      taskID,
 		Description: cmd.Description,
 		Billable:    cmd.Billable,
 	}
@@ -301,7 +289,7 @@ func (cmd *TimeStartCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
@@ -309,11 +297,11 @@ func (cmd *TimeStartCmd) Run(ctx context.Context) error {
 		rows := [][]string{{
 			result.ID.String(),
 			result.Task.ID,
-			result.Start.String(),
+			outfmt.Timestamp(ctx, result.Start),
 			result.Description,
 		}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Timer started (ID: %s)\n", result.ID)
@@ -322,7 +310,7 @@ func (cmd *TimeStartCmd) Run(ctx context.Context) error {
 		fmt.Fprintf(os.Stderr, "  Task: %s (%s)\n", result.Task.Name, result.Task.ID)
 	}
 
-	fmt.Fprintf(os.Stderr, "  Started: %s\n", formatTimestampFromString(result.Start.String()))
+	fmt.Fprintf(os.Stderr, "  Started: %s\n", outfmt.Timestamp(ctx, result.Start))
 
 	if result.Description != "" {
 		fmt.Fprintf(os.Stderr, "  Description: %s\n", result.Description)
@@ -350,7 +338,7 @@ func (cmd *TimeStopCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
@@ -359,11 +347,11 @@ func (cmd *TimeStopCmd) Run(ctx context.Context) error {
 			result.ID.String(),
 			result.Task.ID,
 			result.Duration.String(),
-			result.Start.String(),
-			result.End.String(),
+			outfmt.Timestamp(ctx, result.Start),
+			outfmt.Timestamp(ctx, result.End),
 		}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Timer stopped (ID: %s)\n", result.ID)
@@ -373,8 +361,8 @@ func (cmd *TimeStopCmd) Run(ctx context.Context) error {
 	}
 
 	fmt.Fprintf(os.Stderr, "  Duration: %s\n", formatDurationFromString(result.Duration.String()))
-	fmt.Fprintf(os.Stderr, "  Started: %s\n", formatTimestampFromString(result.Start.String()))
-	fmt.Fprintf(os.Stderr, "  Ended: %s\n", formatTimestampFromString(result.End.String()))
+	fmt.Fprintf(os.Stderr, "  Started: %s\n", outfmt.Timestamp(ctx, result.Start))
+	fmt.Fprintf(os.Stderr, "  Ended: %s\n", outfmt.Timestamp(ctx, result.End))
 
 	return nil
 }
@@ -383,14 +371,24 @@ type TimeUpdateCmd struct {
 	EntryID     string   `arg:"" required:"" help:"Time entry ID"`
 	Description string   `help:"New description"`
 	Duration    int64    `help:"New duration in milliseconds"`
-	Start       int64    `help:"New start time in milliseconds"`
-	End         int64    `help:"New end time in milliseconds"`
+	Start       DateFlag `help:"New start time (e.g. 2026-10-20, \"2026-10-20 17:00\", tomorrow, \"next friday\", eow, +3d)" placeholder:"DATE"`
+	End         DateFlag `help:"New end time" placeholder:"DATE"`
 	Billable    *bool    `help:"Mark as billable (true/false)"`
 	TagAction   string   `help:"Tag action: 'add' or 'remove'"`
 	Tags        []string `help:"Tags to add or remove"`
 }
 
 func (cmd *TimeUpdateCmd) Run(ctx context.Context) error {
+	start, err := cmd.Start.Millis(ctx, "--start")
+	if err != nil {
+		return err
+	}
+
+	end, err := cmd.End.Millis(ctx, "--end")
+	if err != nil {
+		return err
+	}
+
 	client, err := getClickUpClient(ctx)
 	if err != nil {
 		return err
@@ -404,8 +402,8 @@ func (cmd *TimeUpdateCmd) Run(ctx context.Context) error {
 	req := clickup.UpdateTimeEntryRequest{
 		Description: cmd.Description,
 		Duration:    cmd.Duration,
-		Start:       cmd.Start,
-		End:         cmd.End,
+		Start:       start,
+		End:         end,
 		Billable:    cmd.Billable,
 		TagAction:   cmd.TagAction,
 	}
@@ -423,7 +421,7 @@ func (cmd *TimeUpdateCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
@@ -432,11 +430,11 @@ func (cmd *TimeUpdateCmd) Run(ctx context.Context) error {
 			result.ID.String(),
 			result.Task.ID,
 			result.Duration.String(),
-			result.Start.String(),
-			result.End.String(),
+			outfmt.Timestamp(ctx, result.Start),
+			outfmt.Timestamp(ctx, result.End),
 		}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Time entry updated (ID: %s)\n", result.ID)
@@ -446,8 +444,8 @@ func (cmd *TimeUpdateCmd) Run(ctx context.Context) error {
 	}
 
 	fmt.Fprintf(os.Stderr, "  Duration: %s\n", formatDurationFromString(result.Duration.String()))
-	fmt.Fprintf(os.Stderr, "  Start: %s\n", formatTimestampFromString(result.Start.String()))
-	fmt.Fprintf(os.Stderr, "  End: %s\n", formatTimestampFromString(result.End.String()))
+	fmt.Fprintf(os.Stderr, "  Start: %s\n", outfmt.Timestamp(ctx, result.Start))
+	fmt.Fprintf(os.Stderr, "  End: %s\n", outfmt.Timestamp(ctx, result.End))
 
 	return nil
 }
@@ -479,7 +477,7 @@ func (cmd *TimeDeleteCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":   "success",
 			"message":  "Time entry deleted",
 			"entry_id": cmd.EntryID,
@@ -490,7 +488,7 @@ func (cmd *TimeDeleteCmd) Run(ctx context.Context) error {
 		headers := []string{"STATUS", "ENTRY_ID"}
 		rows := [][]string{{"success", cmd.EntryID}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Time entry %s deleted\n", cmd.EntryID)
@@ -519,7 +517,7 @@ func (cmd *TimeHistoryCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
@@ -531,12 +529,12 @@ func (cmd *TimeHistoryCmd) Run(ctx context.Context) error {
 				item.Field,
 				item.Before,
 				item.After,
-				item.Date,
+				outfmt.Timestamp(ctx, item.Date),
 				item.User.Username,
 			})
 		}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	if len(result.Data) == 0 {
@@ -548,7 +546,7 @@ func (cmd *TimeHistoryCmd) Run(ctx context.Context) error {
 	fmt.Fprintf(os.Stderr, "History for time entry %s\n\n", cmd.EntryID)
 
 	for _, item := range result.Data {
-		fmt.Fprintf(os.Stderr, "  [%s] %s: %s -> %s (by %s)\n", item.Date, item.Field, item.Before, item.After, item.User.Username)
+		fmt.Fprintf(os.Stderr, "  [%s] %s: %s -> %s (by %s)\n", outfmt.Timestamp(ctx, item.Date), item.Field, item.Before, item.After, item.User.Username)
 	}
 
 	return nil
@@ -573,32 +571,20 @@ func (cmd *TimeTagsCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"NAME"}
-		var rows [][]string
-		for _, tag := range result.Data {
-			rows = append(rows, []string{tag.Name})
-		}
-
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+	headers := []string{"NAME"}
+	var rows [][]string
+	for _, tag := range result.Data {
+		rows = append(rows, []string{tag.Name})
 	}
 
-	if len(result.Data) == 0 {
-		fmt.Fprintln(os.Stderr, "No tags found")
-
+	if !reportRows(ctx, len(rows), "tags") {
 		return nil
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d time entry tags\n\n", len(result.Data))
-
-	for _, tag := range result.Data {
-		fmt.Printf("  %s\n", tag.Name)
-	}
-
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
 type TimeAddTagsCmd struct {
@@ -635,7 +621,7 @@ func (cmd *TimeAddTagsCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":  "success",
 			"message": "Tags added",
 		})
@@ -645,7 +631,7 @@ func (cmd *TimeAddTagsCmd) Run(ctx context.Context) error {
 		headers := []string{"STATUS"}
 		rows := [][]string{{"success"}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Added tags [%s] to %d time entries\n", cmd.Tags, len(entryIDs))
@@ -687,7 +673,7 @@ func (cmd *TimeRemoveTagsCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":  "success",
 			"message": "Tags removed",
 		})
@@ -697,7 +683,7 @@ func (cmd *TimeRemoveTagsCmd) Run(ctx context.Context) error {
 		headers := []string{"STATUS"}
 		rows := [][]string{{"success"}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Removed tags [%s] from %d time entries\n", cmd.Tags, len(entryIDs))
@@ -731,7 +717,7 @@ func (cmd *TimeRenameTagCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":   "success",
 			"message":  "Tag renamed",
 			"old_name": cmd.OldName,
@@ -743,7 +729,7 @@ func (cmd *TimeRenameTagCmd) Run(ctx context.Context) error {
 		headers := []string{"STATUS", "OLD_NAME", "NEW_NAME"}
 		rows := [][]string{{"success", cmd.OldName, cmd.NewName}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Renamed tag '%s' to '%s'\n", cmd.OldName, cmd.NewName)
@@ -753,19 +739,6 @@ func (cmd *TimeRenameTagCmd) Run(ctx context.Context) error {
 
 // Helper functions
 
-func formatTimestampFromString(s string) string {
-	ms, err := strconv.ParseInt(s, 10, 64)
-	if err != nil {
-		return s
-	}
-
-	return formatTimestamp(ms)
-}
-
-func formatTimestamp(ms int64) string {
-	return time.UnixMilli(ms).UTC().Format(time.RFC3339)
-}
-
 func formatDurationFromString(s string) string {
 	ms, err := strconv.ParseInt(s, 10, 64)
 	if err != nil {
@@ -18,9 +18,9 @@ type TimeLegacyCmd struct {
 }
 
 type TimeLegacyListCmd struct {
-	TaskID        string `arg:"" required:"" help:"Task ID"`
-	CustomTaskIDs bool   `help:"Treat task ID as custom task ID"`
-	TeamID        string `help:"Team ID (required when using custom task IDs)"`
+	TaskID        TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
+	CustomTaskIDs bool    `help:"Treat task ID as custom task ID (IDs like ENG-123 are detected automatically)"`
+	TeamID        string  `help:"Team ID for --custom-task-ids (detected custom IDs use the configured team)"`
 }
 
 func (cmd *TimeLegacyListCmd) Run(ctx context.Context) error {
@@ -29,13 +29,18 @@ func (cmd *TimeLegacyListCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	result, err := client.LegacyTime().List(ctx, cmd.TaskID, cmd.CustomTaskIDs, cmd.TeamID)
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	result, err := client.LegacyTime().List(ctx, taskID, cmd.CustomTaskIDs, cmd.TeamID)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
@@ -44,14 +49,14 @@ func (cmd *TimeLegacyListCmd) Run(ctx context.Context) error {
 		for _, interval := range result.Data {
 			rows = append(rows, []string{
 				interval.ID,
-				strconv.FormatInt(interval.Start, 10),
-				strconv.FormatInt(interval.End, 10),
+				outfmt.Timestamp(ctx, interval.Start),
+				outfmt.Timestamp(ctx, interval.End),
 				strconv.FormatInt(interval.Time, 10),
 				interval.Source,
 			})
 		}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	if len(result.Data) == 0 {
@@ -59,48 +64,63 @@ func (cmd *TimeLegacyListCmd) Run(ctx context.Context) error {
 		return nil
 	}
 
-	fmt.Fprintf(os.Stderr, "Tracked time for task %s\n\n", cmd.TaskID)
+	fmt.Fprintf(os.Stderr, "Tracked time for task %s\n\n", taskID)
 
 	for _, interval := range result.Data {
-		printLegacyTimeInterval(&interval)
+		printLegacyTimeInterval(ctx, &interval)
 	}
 
 	return nil
 }
 
 type TimeLegacyTrackCmd struct {
-	TaskID string `arg:"" required:"" help:"Task ID"`
-	Time   int64  `required:"" help:"Duration in milliseconds"`
-	Start  int64  `help:"Start timestamp in milliseconds"`
-	End    int64  `help:"End timestamp in milliseconds"`
+	TaskID TaskArg  `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
+	Time   int64    `required:"" help:"Duration in milliseconds"`
+	Start  DateFlag `help:"Start time (e.g. 2026-10-20, \"2026-10-20 17:00\", tomorrow, \"next friday\", eow, +3d)" placeholder:"DATE"`
+	End    DateFlag `help:"End time" placeholder:"DATE"`
 }
 
 func (cmd *TimeLegacyTrackCmd) Run(ctx context.Context) error {
+	start, err := cmd.Start.Millis(ctx, "--start")
+	if err != nil {
+		return err
+	}
+
+	end, err := cmd.End.Millis(ctx, "--end")
+	if err != nil {
+		return err
+	}
+
 	client, err := getClickUpClient(ctx)
 	if err != nil {
 		return err
 	}
 
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	req := clickup.TrackTimeRequest{
 		Time:  cmd.Time,
-		Start: cmd.Start,
-		End:   cmd.End,
+		Start: start,
+		End:   end,
 	}
 
-	result, err := client.LegacyTime().Track(ctx, cmd.TaskID, req)
+	result, err := client.LegacyTime().Track(ctx, taskID, req)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID"}
 		rows := [][]string{{result.ID}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Time tracked successfully\n")
@@ -110,38 +130,53 @@ func (cmd *TimeLegacyTrackCmd) Run(ctx context.Context) error {
 }
 
 type TimeLegacyUpdateCmd struct {
-	TaskID     string `arg:"" required:"" help:"Task ID"`
-	IntervalID string `arg:"" required:"" help:"Interval ID"`
-	Time       int64  `help:"Duration in milliseconds"`
-	Start      int64  `help:"Start timestamp in milliseconds"`
-	End        int64  `help:"End timestamp in milliseconds"`
+	TaskID     TaskArg  `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
+	IntervalID string   `arg:"" required:"" help:"Interval ID"`
+	Time       int64    `help:"Duration in milliseconds"`
+	Start      DateFlag `help:"Start time (e.g. 2026-10-20, \"2026-10-20 17:00\", tomorrow, \"next friday\", eow, +3d)" placeholder:"DATE"`
+	End        DateFlag `help:"End time" placeholder:"DATE"`
 }
 
 func (cmd *TimeLegacyUpdateCmd) Run(ctx context.Context) error {
+	start, err := cmd.Start.Millis(ctx, "--start")
+	if err != nil {
+		return err
+	}
+
+	end, err := cmd.End.Millis(ctx, "--end")
+	if err != nil {
+		return err
+	}
+
 	client, err := getClickUpClient(ctx)
 	if err != nil {
 		return err
 	}
 
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	req := clickup.EditTimeRequest{
 		Time:  cmd.Time,
-		Start: cmd.Start,
-		End:   cmd.End,
+		Start: start,
+		End:   end,
 	}
 
-	if err := client.LegacyTime().Edit(ctx, cmd.TaskID, cmd.IntervalID, req); err != nil {
+	if err := client.LegacyTime().Edit(ctx, taskID, cmd.IntervalID, req); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{"status": "success", "interval_id": cmd.IntervalID})
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{"status": "success", "interval_id": cmd.IntervalID})
 	}
 
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "INTERVAL_ID"}
 		rows := [][]string{{"success", cmd.IntervalID}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Time interval updated\n")
@@ -151,8 +186,8 @@ func (cmd *TimeLegacyUpdateCmd) Run(ctx context.Context) error {
 }
 
 type TimeLegacyDeleteCmd struct {
-	TaskID     string `arg:"" required:"" help:"Task ID"`
-	IntervalID string `arg:"" required:"" help:"Interval ID"`
+	TaskID     TaskArg `arg:"" required:"" help:"Task (ID, custom ID or URL)"`
+	IntervalID string  `arg:"" required:"" help:"Interval ID"`
 }
 
 func (cmd *TimeLegacyDeleteCmd) Run(ctx context.Context) error {
@@ -161,19 +196,24 @@ func (cmd *TimeLegacyDeleteCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	if err := client.LegacyTime().Delete(ctx, cmd.TaskID, cmd.IntervalID); err != nil {
+	taskID, err := cmd.TaskID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if err := client.LegacyTime().Delete(ctx, taskID, cmd.IntervalID); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{"status": "success", "message": "Time interval deleted"})
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{"status": "success", "message": "Time interval deleted"})
 	}
 
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "INTERVAL_ID"}
 		rows := [][]string{{"success", cmd.IntervalID}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Time interval %s deleted\n", cmd.IntervalID)
@@ -181,11 +221,11 @@ func (cmd *TimeLegacyDeleteCmd) Run(ctx context.Context) error {
 	return nil
 }
 
-func printLegacyTimeInterval(interval *clickup.LegacyTimeInterval) {
+func printLegacyTimeInterval(ctx context.Context, interval *clickup.LegacyTimeInterval) {
 	fmt.Printf("ID: %s\n", interval.ID)
 	fmt.Printf("  Duration: %s\n", formatLegacyDuration(interval.Time))
-	fmt.Printf("  Start: %s\n", formatLegacyTimestamp(interval.Start))
-	fmt.Printf("  End: %s\n", formatLegacyTimestamp(interval.End))
+	fmt.Printf("  Start: %s\n", outfmt.Timestamp(ctx, interval.Start))
+	fmt.Printf("  End: %s\n", outfmt.Timestamp(ctx, interval.End))
 
 	if interval.Source != "" {
 		fmt.Printf("  Source: %s\n", interval.Source)
@@ -202,8 +242,3 @@ func formatLegacyDuration(ms int64) string {
 
 	return fmt.Sprintf("%dh %dm", hours, minutes)
 }
-
-func formatLegacyTimestamp(ms int64) string {
-	seconds := ms / 1000
-	return fmt.Sprintf("<timestamp:%d>", seconds)
-}
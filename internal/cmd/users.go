@@ -18,8 +18,8 @@ type UsersCmd struct {
 }
 
 type UsersGetCmd struct {
-	TeamID string `arg:"" required:"" help:"Team (workspace) ID"`
-	UserID int    `arg:"" required:"" help:"User ID"`
+	TeamID string  `arg:"" required:"" help:"Team (workspace) ID"`
+	UserID UserArg `arg:"" required:"" help:"User (ID, email or @username)"`
 }
 
 func (cmd *UsersGetCmd) Run(ctx context.Context) error {
@@ -28,13 +28,18 @@ func (cmd *UsersGetCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	result, err := client.Users().Get(ctx, cmd.TeamID, cmd.UserID)
+	userID, err := cmd.UserID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	result, err := client.Users().Get(ctx, cmd.TeamID, userID)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
@@ -45,7 +50,7 @@ func (cmd *UsersGetCmd) Run(ctx context.Context) error {
 			result.Email,
 			formatRole(result.Role),
 		}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	printUserDetail(result)
@@ -75,7 +80,7 @@ func (cmd *UsersInviteCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
@@ -86,7 +91,7 @@ func (cmd *UsersInviteCmd) Run(ctx context.Context) error {
 			result.Email,
 			formatRole(result.Role),
 		}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "User invited successfully\n\n")
@@ -95,10 +100,10 @@ func (cmd *UsersInviteCmd) Run(ctx context.Context) error {
 }
 
 type UsersUpdateCmd struct {
-	TeamID   string `arg:"" required:"" help:"Team (workspace) ID"`
-	UserID   int    `arg:"" required:"" help:"User ID"`
-	Username string `help:"New username"`
-	Admin    bool   `help:"Grant admin role (set to false to remove admin)"`
+	TeamID   string  `arg:"" required:"" help:"Team (workspace) ID"`
+	UserID   UserArg `arg:"" required:"" help:"User (ID, email or @username)"`
+	Username string  `help:"New username"`
+	Admin    bool    `help:"Grant admin role (set to false to remove admin)"`
 }
 
 func (cmd *UsersUpdateCmd) Run(ctx context.Context) error {
@@ -107,18 +112,23 @@ func (cmd *UsersUpdateCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	userID, err := cmd.UserID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	req := clickup.EditUserRequest{
 		Username: cmd.Username,
 		Admin:    cmd.Admin,
 	}
 
-	result, err := client.Users().Update(ctx, cmd.TeamID, cmd.UserID, req)
+	result, err := client.Users().Update(ctx, cmd.TeamID, userID, req)
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
@@ -129,7 +139,7 @@ func (cmd *UsersUpdateCmd) Run(ctx context.Context) error {
 			result.Email,
 			formatRole(result.Role),
 		}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "User updated successfully\n\n")
@@ -138,13 +148,13 @@ func (cmd *UsersUpdateCmd) Run(ctx context.Context) error {
 }
 
 type UsersRemoveCmd struct {
-	TeamID string `arg:"" required:"" help:"Team (workspace) ID"`
-	UserID int    `arg:"" required:"" help:"User ID"`
+	TeamID string  `arg:"" required:"" help:"Team (workspace) ID"`
+	UserID UserArg `arg:"" required:"" help:"User (ID, email or @username)"`
 }
 
 func (cmd *UsersRemoveCmd) Run(ctx context.Context) error {
 	if !forceEnabled(ctx) {
-		fmt.Fprintf(os.Stderr, "Warning: This will remove user %d from workspace %s.\n", cmd.UserID, cmd.TeamID)
+		fmt.Fprintf(os.Stderr, "Warning: This will remove user %s from workspace %s.\n", cmd.UserID, cmd.TeamID)
 		fmt.Fprint(os.Stderr, "Use --force to confirm.\n")
 		return nil
 	}
@@ -154,24 +164,29 @@ func (cmd *UsersRemoveCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	if err := client.Users().Remove(ctx, cmd.TeamID, cmd.UserID); err != nil {
+	userID, err := cmd.UserID.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if err := client.Users().Remove(ctx, cmd.TeamID, userID); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":  "success",
-			"user_id": strconv.Itoa(cmd.UserID),
+			"user_id": strconv.Itoa(userID),
 		})
 	}
 
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"STATUS", "USER_ID"}
-		rows := [][]string{{"success", strconv.Itoa(cmd.UserID)}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		rows := [][]string{{"success", strconv.Itoa(userID)}}
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
-	fmt.Fprintf(os.Stderr, "User %d removed from workspace.\n", cmd.UserID)
+	fmt.Fprintf(os.Stderr, "User %d removed from workspace.\n", userID)
 	return nil
 }
 
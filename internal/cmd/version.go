@@ -19,7 +19,7 @@ type VersionCmd struct{}
 
 func (cmd *VersionCmd) Run(ctx context.Context) error {
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"version": VersionString(),
 			"commit":  commit,
 			"date":    date,
@@ -29,7 +29,7 @@ func (cmd *VersionCmd) Run(ctx context.Context) error {
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"VERSION", "COMMIT", "DATE", "OS"}
 		rows := [][]string{{VersionString(), commit, date, runtime.GOOS + "/" + runtime.GOARCH}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 	fmt.Printf("clickup-cli %s\n", VersionString())
 	fmt.Printf("  Commit: %s\n", commit)
@@ -19,10 +19,10 @@ type ViewsCmd struct {
 }
 
 type ViewsListCmd struct {
-	Team   string `help:"List views in a workspace/team"`
-	Space  string `help:"List views in a space"`
-	Folder string `help:"List views in a folder"`
-	List   string `help:"List views in a list"`
+	Team   string    `help:"List views in a workspace/team"`
+	Space  SpaceArg  `help:"List views in a space"`
+	Folder FolderArg `help:"List views in a folder"`
+	List   ListArg   `help:"List views in a list"`
 }
 
 func (cmd *ViewsListCmd) Run(ctx context.Context) error {
@@ -31,21 +31,36 @@ func (cmd *ViewsListCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	spaceID, err := cmd.Space.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	folderID, err := cmd.Folder.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	listID, err := cmd.List.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	// Validate exactly one scope is provided
 	scopes := 0
 	if cmd.Team != "" {
 		scopes++
 	}
 
-	if cmd.Space != "" {
+	if spaceID != "" {
 		scopes++
 	}
 
-	if cmd.Folder != "" {
+	if folderID != "" {
 		scopes++
 	}
 
-	if cmd.List != "" {
+	if listID != "" {
 		scopes++
 	}
 
@@ -58,12 +73,12 @@ func (cmd *ViewsListCmd) Run(ctx context.Context) error {
 	switch {
 	case cmd.Team != "":
 		result, err = client.Views().ListByTeam(ctx, cmd.Team)
-	case cmd.Space != "":
-		result, err = client.Views().ListBySpace(ctx, cmd.Space)
-	case cmd.Folder != "":
-		result, err = client.Views().ListByFolder(ctx, cmd.Folder)
-	case cmd.List != "":
-		result, err = client.Views().ListByList(ctx, cmd.List)
+	case spaceID != "":
+		result, err = client.Views().ListBySpace(ctx, spaceID)
+	case folderID != "":
+		result, err = client.Views().ListByFolder(ctx, folderID)
+	case listID != "":
+		result, err = client.Views().ListByList(ctx, listID)
 	}
 
 	if err != nil {
@@ -71,47 +86,27 @@ func (cmd *ViewsListCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "NAME", "TYPE", "PARENT_TYPE", "PARENT_ID"}
-		var rows [][]string
-
-		for _, view := range result.Views {
-			rows = append(rows, []string{
-				view.ID,
-				view.Name,
-				view.Type,
-				fmt.Sprintf("%d", view.Parent.Type),
-				view.Parent.ID,
-			})
-		}
+	headers := []string{"ID", "NAME", "TYPE", "PARENT_TYPE", "PARENT_ID"}
+	var rows [][]string
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+	for _, view := range result.Views {
+		rows = append(rows, []string{
+			view.ID,
+			view.Name,
+			view.Type,
+			fmt.Sprintf("%d", view.Parent.Type),
+			view.Parent.ID,
+		})
 	}
 
-	// Human-readable output
-	if len(result.Views) == 0 {
-		fmt.Fprintln(os.Stderr, "No views found")
-
+	if !reportRows(ctx, len(rows), "views") {
 		return nil
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d views:\n\n", len(result.Views))
-
-	for _, view := range result.Views {
-		protected := ""
-		if view.Protected {
-			protected = " (protected)"
-		}
-
-		fmt.Printf("  %s (%s)%s\n", view.Name, view.Type, protected)
-		fmt.Printf("    ID: %s\n", view.ID)
-		fmt.Printf("    Parent: type=%d id=%s\n", view.Parent.Type, view.Parent.ID)
-	}
-
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
 type ViewsGetCmd struct {
@@ -130,7 +125,7 @@ func (cmd *ViewsGetCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
@@ -142,7 +137,7 @@ func (cmd *ViewsGetCmd) Run(ctx context.Context) error {
 
 		rows := [][]string{{result.ID, result.Name, result.Type, protected}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	// Human-readable output
@@ -159,8 +154,9 @@ func (cmd *ViewsGetCmd) Run(ctx context.Context) error {
 }
 
 type ViewsTasksCmd struct {
-	ViewID string `arg:"" required:"" help:"View ID"`
-	Page   int    `help:"Page number for pagination"`
+	ViewID          string `arg:"" required:"" help:"View ID"`
+	Page            int    `help:"Page number for pagination"`
+	PaginationFlags `embed:""`
 }
 
 func (cmd *ViewsTasksCmd) Run(ctx context.Context) error {
@@ -169,67 +165,40 @@ func (cmd *ViewsTasksCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	result, err := client.Views().Tasks(ctx, cmd.ViewID, cmd.Page)
-	if err != nil {
-		return err
+	headers := []string{"ID", "NAME", "STATUS", "PRIORITY", "URL"}
+	row := func(task *clickup.Task) []outfmt.Cell {
+		return []outfmt.Cell{{Text: task.ID}, {Text: task.Name}, taskStatusCell(task), taskPriorityCell(task), {Text: task.URL}}
 	}
 
-	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+	if cmd.PaginationFlags.enabled() {
+		return writeTaskStream(ctx,
+			client.Views().TasksIter(ctx, cmd.ViewID, cmd.Page),
+			cmd.Limit,
+			headers,
+			row,
+			func(tasks []clickup.Task) any { return clickup.ViewTasksResponse{Tasks: tasks} },
+		)
 	}
 
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "NAME", "STATUS", "PRIORITY", "URL"}
-		var rows [][]string
-
-		for _, task := range result.Tasks {
-			priority := ""
-			if task.Priority != nil {
-				priority = task.Priority.Name
-			}
-
-			rows = append(rows, []string{
-				task.ID,
-				task.Name,
-				task.Status.Status,
-				priority,
-				task.URL,
-			})
-		}
-
-		return outfmt.WritePlain(os.Stdout, headers, rows)
-	}
-
-	// Human-readable output
-	if len(result.Tasks) == 0 {
-		fmt.Fprintln(os.Stderr, "No tasks found in this view")
-
-		return nil
+	result, err := client.Views().Tasks(ctx, cmd.ViewID, cmd.Page)
+	if err != nil {
+		return err
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d tasks in view:\n\n", len(result.Tasks))
-
-	for _, task := range result.Tasks {
-		fmt.Printf("  %s (%s)\n", task.Name, task.Status.Status)
-		fmt.Printf("    ID: %s\n", task.ID)
-
-		if task.Priority != nil {
-			fmt.Printf("    Priority: %s\n", task.Priority.Name)
-		}
-
-		fmt.Printf("    URL: %s\n", task.URL)
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	return nil
+	return writeTaskRows(ctx, taskSeq(result.Tasks), 0, headers, row)
 }
 
 type ViewsCreateCmd struct {
-	Team   string `help:"Create view in a workspace/team"`
-	Space  string `help:"Create view in a space"`
-	Folder string `help:"Create view in a folder"`
-	List   string `help:"Create view in a list"`
-	Name   string `arg:"" required:"" help:"View name"`
-	Type   string `required:"" help:"View type (list, board, calendar, gantt, activity, map, workload, table)"`
+	Team   string    `help:"Create view in a workspace/team"`
+	Space  SpaceArg  `help:"Create view in a space"`
+	Folder FolderArg `help:"Create view in a folder"`
+	List   ListArg   `help:"Create view in a list"`
+	Name   string    `arg:"" required:"" help:"View name"`
+	Type   string    `required:"" help:"View type (list, board, calendar, gantt, activity, map, workload, table)"`
 }
 
 func (cmd *ViewsCreateCmd) Run(ctx context.Context) error {
@@ -238,21 +207,36 @@ func (cmd *ViewsCreateCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	spaceID, err := cmd.Space.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	folderID, err := cmd.Folder.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	listID, err := cmd.List.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	// Validate exactly one scope is provided
 	scopes := 0
 	if cmd.Team != "" {
 		scopes++
 	}
 
-	if cmd.Space != "" {
+	if spaceID != "" {
 		scopes++
 	}
 
-	if cmd.Folder != "" {
+	if folderID != "" {
 		scopes++
 	}
 
-	if cmd.List != "" {
+	if listID != "" {
 		scopes++
 	}
 
@@ -270,12 +254,12 @@ func (cmd *ViewsCreateCmd) Run(ctx context.Context) error {
 	switch {
 	case cmd.Team != "":
 		result, err = client.Views().CreateInTeam(ctx, cmd.Team, req)
-	case cmd.Space != "":
-		result, err = client.Views().CreateInSpace(ctx, cmd.Space, req)
-	case cmd.Folder != "":
-		result, err = client.Views().CreateInFolder(ctx, cmd.Folder, req)
-	case cmd.List != "":
-		result, err = client.Views().CreateInList(ctx, cmd.List, req)
+	case spaceID != "":
+		result, err = client.Views().CreateInSpace(ctx, spaceID, req)
+	case folderID != "":
+		result, err = client.Views().CreateInFolder(ctx, folderID, req)
+	case listID != "":
+		result, err = client.Views().CreateInList(ctx, listID, req)
 	}
 
 	if err != nil {
@@ -283,14 +267,14 @@ func (cmd *ViewsCreateCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME", "TYPE"}
 		rows := [][]string{{result.ID, result.Name, result.Type}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "View created\n")
@@ -322,14 +306,14 @@ func (cmd *ViewsUpdateCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "NAME", "TYPE"}
 		rows := [][]string{{result.ID, result.Name, result.Type}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "View updated\n")
@@ -362,7 +346,7 @@ func (cmd *ViewsDeleteCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":  "success",
 			"message": "View deleted",
 			"view_id": cmd.ViewID,
@@ -373,7 +357,7 @@ func (cmd *ViewsDeleteCmd) Run(ctx context.Context) error {
 		headers := []string{"STATUS", "VIEW_ID"}
 		rows := [][]string{{"success", cmd.ViewID}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "View %s deleted\n", cmd.ViewID)
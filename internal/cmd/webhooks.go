@@ -33,52 +33,36 @@ func (cmd *WebhooksListCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "ENDPOINT", "STATUS", "EVENTS"}
-		var rows [][]string
-
-		for _, webhook := range result.Webhooks {
-			rows = append(rows, []string{
-				webhook.ID,
-				webhook.Endpoint,
-				webhook.Status,
-				strings.Join(webhook.Events, ","),
-			})
-		}
+	headers := []string{"ID", "ENDPOINT", "STATUS", "EVENTS"}
+	var rows [][]string
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+	for _, webhook := range result.Webhooks {
+		rows = append(rows, []string{
+			webhook.ID,
+			webhook.Endpoint,
+			webhook.Status,
+			strings.Join(webhook.Events, ","),
+		})
 	}
 
-	// Human-readable output
-	if len(result.Webhooks) == 0 {
-		fmt.Fprintln(os.Stderr, "No webhooks found")
-
+	if !reportRows(ctx, len(rows), "webhooks") {
 		return nil
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d webhooks:\n\n", len(result.Webhooks))
-
-	for _, webhook := range result.Webhooks {
-		fmt.Printf("  %s\n", webhook.Endpoint)
-		fmt.Printf("    ID: %s\n", webhook.ID)
-		fmt.Printf("    Status: %s\n", webhook.Status)
-		fmt.Printf("    Events: %s\n", strings.Join(webhook.Events, ", "))
-	}
-
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
 type WebhooksCreateCmd struct {
-	TeamID   string `arg:"" required:"" help:"Workspace/Team ID"`
-	Endpoint string `required:"" help:"Webhook endpoint URL"`
-	Events   string `required:"" help:"Comma-separated list of events"`
-	Space    string `help:"Scope to space ID"`
-	Folder   string `help:"Scope to folder ID"`
-	List     string `help:"Scope to list ID"`
-	Task     string `help:"Scope to task ID"`
+	TeamID   string    `arg:"" required:"" help:"Workspace/Team ID"`
+	Endpoint string    `required:"" help:"Webhook endpoint URL"`
+	Events   string    `required:"" help:"Comma-separated list of events"`
+	Space    SpaceArg  `help:"Scope to space (ID, name or URL)"`
+	Folder   FolderArg `help:"Scope to folder (ID, name, path or URL)"`
+	List     ListArg   `help:"Scope to list (ID, name, path or URL)"`
+	Task     TaskArg   `help:"Scope to task (ID, custom ID or URL)"`
 }
 
 func (cmd *WebhooksCreateCmd) Run(ctx context.Context) error {
@@ -87,13 +71,33 @@ func (cmd *WebhooksCreateCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	spaceID, err := cmd.Space.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	folderID, err := cmd.Folder.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	listID, err := cmd.List.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	taskID, err := cmd.Task.Resolve(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	req := clickup.CreateWebhookRequest{
 		Endpoint: cmd.Endpoint,
 		Events:   strings.Split(cmd.Events, ","),
-		SpaceID:  cmd.Space,
-		FolderID: cmd.Folder,
-		ListID:   cmd.List,
-		TaskID:   cmd.Task,
+		SpaceID:  spaceID,
+		FolderID: folderID,
+		ListID:   listID,
+		TaskID:   taskID,
 	}
 
 	result, err := client.Webhooks().Create(ctx, cmd.TeamID, req)
@@ -102,14 +106,14 @@ func (cmd *WebhooksCreateCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "ENDPOINT", "STATUS"}
 		rows := [][]string{{result.ID, result.Endpoint, result.Status}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Webhook created\n")
@@ -149,14 +153,14 @@ func (cmd *WebhooksUpdateCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"ID", "ENDPOINT", "STATUS"}
 		rows := [][]string{{result.ID, result.Endpoint, result.Status}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Webhook updated\n")
@@ -189,7 +193,7 @@ func (cmd *WebhooksDeleteCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]string{
+		return outfmt.WriteJSON(ctx, os.Stdout, map[string]string{
 			"status":     "success",
 			"message":    "Webhook deleted",
 			"webhook_id": cmd.WebhookID,
@@ -200,7 +204,7 @@ func (cmd *WebhooksDeleteCmd) Run(ctx context.Context) error {
 		headers := []string{"STATUS", "WEBHOOK_ID"}
 		rows := [][]string{{"success", cmd.WebhookID}}
 
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Webhook %s deleted\n", cmd.WebhookID)
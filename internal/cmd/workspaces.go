@@ -6,7 +6,6 @@ import (
 	"os"
 	"strconv"
 
-	"github.com/builtbyrobben/clickup-cli/internal/clickup"
 	"github.com/builtbyrobben/clickup-cli/internal/outfmt"
 )
 
@@ -30,29 +29,20 @@ func (cmd *WorkspacesListCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
-	}
-	if outfmt.IsPlain(ctx) {
-		headers := []string{"ID", "NAME", "MEMBER_COUNT"}
-		var rows [][]string
-		for _, team := range result.Teams {
-			rows = append(rows, []string{team.ID, team.Name, strconv.Itoa(len(team.Members))})
-		}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 
-	if len(result.Teams) == 0 {
-		fmt.Fprintln(os.Stderr, "No workspaces found")
-		return nil
+	headers := []string{"ID", "NAME", "MEMBER_COUNT"}
+	var rows [][]string
+	for _, team := range result.Teams {
+		rows = append(rows, []string{team.ID, team.Name, strconv.Itoa(len(team.Members))})
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d workspaces\n\n", len(result.Teams))
-
-	for _, team := range result.Teams {
-		printWorkspace(&team)
+	if !reportRows(ctx, len(rows), "workspaces") {
+		return nil
 	}
 
-	return nil
+	return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 }
 
 type WorkspacesPlanCmd struct {
@@ -71,12 +61,12 @@ func (cmd *WorkspacesPlanCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"TEAM_ID", "PLAN_ID", "PLAN_NAME"}
 		rows := [][]string{{result.TeamID, strconv.Itoa(result.PlanID), result.PlanName}}
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Workspace Plan\n\n")
@@ -102,7 +92,7 @@ func (cmd *WorkspacesSeatsCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSON(ctx, os.Stdout, result)
 	}
 	if outfmt.IsPlain(ctx) {
 		headers := []string{"TYPE", "FILLED", "TOTAL", "EMPTY"}
@@ -119,7 +109,7 @@ func (cmd *WorkspacesSeatsCmd) Run(ctx context.Context) error {
 			strconv.Itoa(result.Guests.TotalSeats),
 			strconv.Itoa(result.Guests.EmptySeats),
 		})
-		return outfmt.WritePlain(os.Stdout, headers, rows)
+		return outfmt.WritePlain(ctx, os.Stdout, headers, rows)
 	}
 
 	fmt.Fprintf(os.Stderr, "Workspace Seat Usage\n\n")
@@ -134,7 +124,3 @@ func (cmd *WorkspacesSeatsCmd) Run(ctx context.Context) error {
 
 	return nil
 }
-
-func printWorkspace(team *clickup.Workspace) {
-	fmt.Printf("%s: %s (%d members)\n", team.ID, team.Name, len(team.Members))
-}
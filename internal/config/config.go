@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"runtime"
+	"slices"
 	"strings"
 )
 
@@ -84,6 +86,16 @@ func EnsureKeyringDir() (string, error) {
 	return keyringDir, nil
 }
 
+// CacheDir returns the directory holding cached API responses.
+func CacheDir() (string, error) {
+	configDir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "cache"), nil
+}
+
 // ConfigPath returns the path to the config file.
 func ConfigPath() (string, error) {
 	configDir, err := ConfigDir()
@@ -100,98 +112,283 @@ func NormalizeEnvVarName(cliName string) string {
 	return strings.ToUpper(strings.ReplaceAll(cliName, "-", "_"))
 }
 
-// configData is the structure of config.json.
-type configData struct {
+// DefaultProfile is the profile used when none is selected. Configs written
+// before profiles existed are migrated into it.
+const DefaultProfile = "default"
+
+var (
+	ErrProfileNotFound    = errors.New("profile not found")
+	ErrInvalidProfileName = errors.New("invalid profile name")
+)
+
+var profileNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// activeProfile is set from --profile / CLICKUP_PROFILE and overrides the
+// profile selected in the config file.
+var activeProfile string
+
+// ProfileData holds the per-profile settings in config.json.
+type ProfileData struct {
 	TeamID      string `json:"team_id,omitempty"`
 	WorkspaceID string `json:"workspace_id,omitempty"`
 }
 
-// GetTeamID reads the team ID from the config file.
-func GetTeamID() (string, error) {
-	cfgPath, err := ConfigPath()
+// configData is the structure of config.json. TeamID and WorkspaceID are the
+// pre-profile layout, read only for migration.
+type configData struct {
+	CurrentProfile string                  `json:"current_profile,omitempty"`
+	Profiles       map[string]*ProfileData `json:"profiles,omitempty"`
+	TeamID         string                  `json:"team_id,omitempty"`
+	WorkspaceID    string                  `json:"workspace_id,omitempty"`
+}
+
+// ValidateProfileName checks that name is usable as a profile name.
+func ValidateProfileName(name string) error {
+	if !profileNamePattern.MatchString(name) {
+		return fmt.Errorf("%w: %q (use letters, digits, '-' and '_')", ErrInvalidProfileName, name)
+	}
+
+	return nil
+}
+
+// SetActiveProfile selects the profile for this process, overriding the
+// config file's current profile. An empty name clears the override. Unless
+// create is set, as for commands that store credentials or IDs, the profile
+// must already exist; the default profile always does.
+func SetActiveProfile(name string, create bool) error {
+	if name != "" {
+		if err := ValidateProfileName(name); err != nil {
+			return err
+		}
+	}
+
+	if name != "" && name != DefaultProfile && !create {
+		names, err := ListProfiles()
+		if err != nil {
+			return err
+		}
+
+		if !slices.Contains(names, name) {
+			return unknownProfileError(name, names)
+		}
+	}
+
+	activeProfile = name
+
+	return nil
+}
+
+func unknownProfileError(name string, existing []string) error {
+	if len(existing) == 0 {
+		return fmt.Errorf("%w: %s (no profiles configured)", ErrProfileNotFound, name)
+	}
+
+	return fmt.Errorf("%w: %s (existing profiles: %s)", ErrProfileNotFound, name, strings.Join(existing, ", "))
+}
+
+// ActiveProfile returns the profile in effect: the override set by
+// SetActiveProfile, else the config file's current profile, else "default".
+func ActiveProfile() string {
+	if activeProfile != "" {
+		return activeProfile
+	}
+
+	cfg, err := readConfig()
+	if err == nil && cfg.CurrentProfile != "" {
+		return cfg.CurrentProfile
+	}
+
+	return DefaultProfile
+}
+
+// ListProfiles returns the names of all configured profiles, sorted.
+func ListProfiles() ([]string, error) {
+	cfg, err := readConfig()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	data, err := os.ReadFile(cfgPath) //nolint:gosec // path is from ConfigPath(), not user input
+	names := make([]string, 0, len(cfg.Profiles))
+	for name := range cfg.Profiles {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names, nil
+}
+
+// GetProfile returns the settings of a profile.
+func GetProfile(name string) (ProfileData, error) {
+	cfg, err := readConfig()
 	if err != nil {
-		if os.IsNotExist(err) {
-			return "", nil
+		return ProfileData{}, err
+	}
+
+	p, ok := cfg.Profiles[name]
+	if !ok {
+		return ProfileData{}, fmt.Errorf("%w: %s", ErrProfileNotFound, name)
+	}
+
+	return *p, nil
+}
+
+// EnsureProfile creates an empty profile if it doesn't exist yet.
+func EnsureProfile(name string) error {
+	return updateConfig(func(cfg *configData) error {
+		profileFor(cfg, name)
+		return nil
+	})
+}
+
+// UseProfile makes name the current profile in the config file.
+func UseProfile(name string) error {
+	return updateConfig(func(cfg *configData) error {
+		if _, ok := cfg.Profiles[name]; !ok {
+			return fmt.Errorf("%w: %s", ErrProfileNotFound, name)
+		}
+
+		cfg.CurrentProfile = name
+
+		return nil
+	})
+}
+
+// DeleteProfile removes a profile. Deleting the current profile switches
+// back to the default one.
+func DeleteProfile(name string) error {
+	return updateConfig(func(cfg *configData) error {
+		if _, ok := cfg.Profiles[name]; !ok {
+			return fmt.Errorf("%w: %s", ErrProfileNotFound, name)
+		}
+
+		delete(cfg.Profiles, name)
+
+		if cfg.CurrentProfile == name {
+			cfg.CurrentProfile = ""
 		}
 
-		return "", fmt.Errorf("read config file: %w", err)
+		return nil
+	})
+}
+
+// GetTeamID reads the active profile's team ID from the config file.
+func GetTeamID() (string, error) {
+	cfg, err := readConfig()
+	if err != nil {
+		return "", err
 	}
 
-	var cfg configData
-	if err := json.Unmarshal(data, &cfg); err != nil {
-		return "", fmt.Errorf("parse config file: %w", err)
+	if p, ok := cfg.Profiles[ActiveProfile()]; ok {
+		return p.TeamID, nil
 	}
 
-	return cfg.TeamID, nil
+	return "", nil
 }
 
-// GetWorkspaceID reads the workspace ID from the config file.
+// GetWorkspaceID reads the active profile's workspace ID from the config file.
 func GetWorkspaceID() (string, error) {
-	cfgPath, err := ConfigPath()
+	cfg, err := readConfig()
 	if err != nil {
 		return "", err
 	}
 
-	data, err := os.ReadFile(cfgPath) //nolint:gosec // path is from ConfigPath(), not user input
-	if err != nil {
-		if os.IsNotExist(err) {
-			return "", nil
-		}
+	if p, ok := cfg.Profiles[ActiveProfile()]; ok {
+		return p.WorkspaceID, nil
+	}
+
+	return "", nil
+}
+
+// SetTeamID writes the active profile's team ID to the config file.
+func SetTeamID(teamID string) error {
+	profile := ActiveProfile()
+
+	return updateConfig(func(cfg *configData) error {
+		profileFor(cfg, profile).TeamID = teamID
+		return nil
+	})
+}
+
+// SetWorkspaceID writes the active profile's workspace ID to the config file.
+func SetWorkspaceID(workspaceID string) error {
+	profile := ActiveProfile()
+
+	return updateConfig(func(cfg *configData) error {
+		profileFor(cfg, profile).WorkspaceID = workspaceID
+		return nil
+	})
+}
 
-		return "", fmt.Errorf("read config file: %w", err)
+func profileFor(cfg *configData, name string) *ProfileData {
+	if cfg.Profiles == nil {
+		cfg.Profiles = map[string]*ProfileData{}
 	}
 
-	var cfg configData
-	if err := json.Unmarshal(data, &cfg); err != nil {
-		return "", fmt.Errorf("parse config file: %w", err)
+	p, ok := cfg.Profiles[name]
+	if !ok {
+		p = &ProfileData{}
+		cfg.Profiles[name] = p
 	}
 
-	return cfg.WorkspaceID, nil
+	return p
 }
 
-// SetTeamID writes the team ID to the config file.
-func SetTeamID(teamID string) error {
-	_, err := EnsureConfigDir()
+// readConfig loads config.json, migrating the pre-profile layout into the
+// default profile. A missing file is an empty config.
+func readConfig() (configData, error) {
+	var cfg configData
+
+	cfgPath, err := ConfigPath()
 	if err != nil {
-		return err
+		return cfg, err
 	}
 
-	cfgPath, err := ConfigPath()
+	data, err := os.ReadFile(cfgPath) //nolint:gosec // path is from ConfigPath(), not user input
 	if err != nil {
-		return err
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+
+		return cfg, fmt.Errorf("read config file: %w", err)
 	}
 
-	// Read existing config or start fresh
-	var cfg configData
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("parse config file: %w", err)
+	}
 
-	data, err := os.ReadFile(cfgPath) //nolint:gosec // path is from ConfigPath(), not user input
-	if err == nil {
-		_ = json.Unmarshal(data, &cfg)
+	if migrateLegacy(&cfg) {
+		// Best effort: the migrated layout is used in memory either way.
+		_ = writeConfig(cfgPath, cfg)
 	}
 
-	cfg.TeamID = teamID
+	return cfg, nil
+}
 
-	out, err := json.MarshalIndent(cfg, "", "  ")
-	if err != nil {
-		return fmt.Errorf("marshal config: %w", err)
+// migrateLegacy moves top-level team/workspace IDs into the default profile.
+func migrateLegacy(cfg *configData) bool {
+	if cfg.TeamID == "" && cfg.WorkspaceID == "" {
+		return false
 	}
 
-	if err := os.WriteFile(cfgPath, out, 0o600); err != nil {
-		return fmt.Errorf("write config file: %w", err)
+	p := profileFor(cfg, DefaultProfile)
+
+	if p.TeamID == "" {
+		p.TeamID = cfg.TeamID
 	}
 
-	return nil
+	if p.WorkspaceID == "" {
+		p.WorkspaceID = cfg.WorkspaceID
+	}
+
+	cfg.TeamID = ""
+	cfg.WorkspaceID = ""
+
+	return true
 }
 
-// SetWorkspaceID writes the workspace ID to the config file.
-func SetWorkspaceID(workspaceID string) error {
-	_, err := EnsureConfigDir()
-	if err != nil {
+func updateConfig(fn func(*configData) error) error {
+	if _, err := EnsureConfigDir(); err != nil {
 		return err
 	}
 
@@ -200,16 +397,19 @@ func SetWorkspaceID(workspaceID string) error {
 		return err
 	}
 
-	// Read existing config or start fresh
-	var cfg configData
+	cfg, err := readConfig()
+	if err != nil {
+		return err
+	}
 
-	data, err := os.ReadFile(cfgPath) //nolint:gosec // path is from ConfigPath(), not user input
-	if err == nil {
-		_ = json.Unmarshal(data, &cfg)
+	if err := fn(&cfg); err != nil {
+		return err
 	}
 
-	cfg.WorkspaceID = workspaceID
+	return writeConfig(cfgPath, cfg)
+}
 
+func writeConfig(cfgPath string, cfg configData) error {
 	out, err := json.MarshalIndent(cfg, "", "  ")
 	if err != nil {
 		return fmt.Errorf("marshal config: %w", err)
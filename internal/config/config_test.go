@@ -0,0 +1,114 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// useTempConfig points the config directory at a temporary one on every
+// platform and returns the config file path. Tests using it change the
+// environment and the active profile, so they cannot run in parallel.
+func useTempConfig(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("APPDATA", dir)
+	t.Cleanup(func() { activeProfile = "" })
+
+	path, err := ConfigPath()
+	if err != nil {
+		t.Fatalf("ConfigPath() error = %v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatalf("create config dir: %v", err)
+	}
+
+	return path
+}
+
+func TestReadConfig_MigratesLegacyLayout(t *testing.T) {
+	path := useTempConfig(t)
+
+	if err := os.WriteFile(path, []byte(`{"team_id":"T1","workspace_id":"W1"}`), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	if id, err := GetTeamID(); err != nil || id != "T1" {
+		t.Fatalf("GetTeamID() = %q, %v, want T1", id, err)
+	}
+
+	if id, err := GetWorkspaceID(); err != nil || id != "W1" {
+		t.Fatalf("GetWorkspaceID() = %q, %v, want W1", id, err)
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read config: %v", err)
+	}
+
+	var cfg configData
+	if err := json.Unmarshal(raw, &cfg); err != nil {
+		t.Fatalf("parse config: %v", err)
+	}
+
+	p := cfg.Profiles[DefaultProfile]
+	if cfg.TeamID != "" || cfg.WorkspaceID != "" || p == nil || p.TeamID != "T1" || p.WorkspaceID != "W1" {
+		t.Fatalf("expected the legacy IDs to move into the default profile, got %s", raw)
+	}
+}
+
+func TestReadConfig_MigrationKeepsProfileValues(t *testing.T) {
+	path := useTempConfig(t)
+
+	legacy := `{"team_id":"T-old","profiles":{"default":{"team_id":"T-new"}}}`
+	if err := os.WriteFile(path, []byte(legacy), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	if id, err := GetTeamID(); err != nil || id != "T-new" {
+		t.Fatalf("GetTeamID() = %q, %v, want the profile's T-new", id, err)
+	}
+}
+
+func TestSetActiveProfile_RejectsUnknownProfiles(t *testing.T) {
+	useTempConfig(t)
+
+	err := SetActiveProfile("acme", false)
+	if !errors.Is(err, ErrProfileNotFound) || !strings.Contains(err.Error(), "no profiles configured") {
+		t.Fatalf("SetActiveProfile() error = %v, want profile not found", err)
+	}
+
+	for _, name := range []string{"work", "home"} {
+		if err := EnsureProfile(name); err != nil {
+			t.Fatalf("EnsureProfile(%q) error = %v", name, err)
+		}
+	}
+
+	err = SetActiveProfile("acme", false)
+	if !errors.Is(err, ErrProfileNotFound) || !strings.Contains(err.Error(), "existing profiles: home, work") {
+		t.Fatalf("SetActiveProfile() error = %v, want the existing profiles listed", err)
+	}
+
+	if err := SetActiveProfile("work", false); err != nil || ActiveProfile() != "work" {
+		t.Fatalf("SetActiveProfile(work) = %v, active %q", err, ActiveProfile())
+	}
+
+	if err := SetActiveProfile(DefaultProfile, false); err != nil {
+		t.Fatalf("expected the default profile to always be accepted, got %v", err)
+	}
+
+	if err := SetActiveProfile("acme", true); err != nil || ActiveProfile() != "acme" {
+		t.Fatalf("SetActiveProfile(acme, create) = %v, active %q", err, ActiveProfile())
+	}
+
+	if err := SetActiveProfile("bad name", true); !errors.Is(err, ErrInvalidProfileName) {
+		t.Fatalf("expected an invalid name error, got %v", err)
+	}
+}
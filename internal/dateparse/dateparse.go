@@ -0,0 +1,173 @@
+// Package dateparse turns the dates users type on the command line into
+// times: ISO 8601, "2026-10-20 17:00", Unix milliseconds, day words such as
+// "tomorrow" and "next friday", period ends such as "eow", and offsets from
+// now such as "+3d" or "in 2 hours".
+package dateparse
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalid is returned for input that matches no supported form.
+var ErrInvalid = errors.New("unrecognised date")
+
+// Layouts tried for absolute dates, in order. Layouts without a zone are
+// read in the location of now.
+var layouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+var (
+	offsetRe = regexp.MustCompile(`^([+-])?(\d+)\s*([a-z]+)$`)
+	phraseRe = regexp.MustCompile(`^(?:in\s+(\d+)\s*([a-z]+)|(\d+)\s*([a-z]+)\s+ago)$`)
+)
+
+// Parse resolves input relative to now, in now's location. Day words
+// resolve to midnight; eod, eow (Sunday) and eom resolve to the last second
+// of the day, week or month. A bare integer is taken as Unix milliseconds.
+func Parse(input string, now time.Time) (time.Time, error) {
+	s := strings.ToLower(strings.TrimSpace(input))
+	if s == "" {
+		return time.Time{}, fmt.Errorf("%w: empty", ErrInvalid)
+	}
+
+	if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.UnixMilli(ms).In(now.Location()), nil
+	}
+
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, strings.ToUpper(s), now.Location()); err == nil {
+			return t, nil
+		}
+	}
+
+	if t, ok := parseWord(s, now); ok {
+		return t, nil
+	}
+
+	if t, ok := parseOffset(s, now); ok {
+		return t, nil
+	}
+
+	return time.Time{}, fmt.Errorf("%w %q (try 2026-10-20, \"2026-10-20 17:00\", tomorrow, \"next friday\", eow or +3d)", ErrInvalid, input)
+}
+
+func parseWord(s string, now time.Time) (time.Time, bool) {
+	today := startOfDay(now)
+
+	switch s {
+	case "now":
+		return now, true
+	case "today":
+		return today, true
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), true
+	case "yesterday":
+		return today.AddDate(0, 0, -1), true
+	case "eod":
+		return endOf(today), true
+	case "eow":
+		// Weeks end on Sunday.
+		days := (7 - int(today.Weekday())) % 7
+		return endOf(today.AddDate(0, 0, days)), true
+	case "eom":
+		first := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, today.Location())
+		return endOf(first.AddDate(0, 1, -1)), true
+	}
+
+	// "friday" and "next friday" are the next Friday after today; "this
+	// friday" may be today.
+	name, includeToday := s, false
+	if rest, ok := strings.CutPrefix(s, "next "); ok {
+		name = rest
+	} else if rest, ok := strings.CutPrefix(s, "this "); ok {
+		name, includeToday = rest, true
+	}
+
+	day, ok := weekday(name)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	days := (int(day) - int(today.Weekday()) + 7) % 7
+	if days == 0 && !includeToday {
+		days = 7
+	}
+
+	return today.AddDate(0, 0, days), true
+}
+
+func parseOffset(s string, now time.Time) (time.Time, bool) {
+	sign, amount, unit := "+", "", ""
+
+	if m := offsetRe.FindStringSubmatch(s); m != nil {
+		if m[1] != "" {
+			sign = m[1]
+		}
+
+		amount, unit = m[2], m[3]
+	} else if m := phraseRe.FindStringSubmatch(s); m != nil {
+		if m[1] != "" {
+			amount, unit = m[1], m[2]
+		} else {
+			sign, amount, unit = "-", m[3], m[4]
+		}
+	} else {
+		return time.Time{}, false
+	}
+
+	n, err := strconv.Atoi(amount)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	if sign == "-" {
+		n = -n
+	}
+
+	switch strings.TrimSuffix(unit, "s") {
+	case "m", "min", "minute":
+		return now.Add(time.Duration(n) * time.Minute), true
+	case "h", "hr", "hour":
+		return now.Add(time.Duration(n) * time.Hour), true
+	case "d", "day":
+		return now.AddDate(0, 0, n), true
+	case "w", "wk", "week":
+		return now.AddDate(0, 0, 7*n), true
+	case "mo", "month":
+		return now.AddDate(0, n, 0), true
+	case "y", "yr", "year":
+		return now.AddDate(n, 0, 0), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+func weekday(name string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		full := strings.ToLower(d.String())
+		if name == full || name == full[:3] {
+			return d, true
+		}
+	}
+
+	return 0, false
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func endOf(day time.Time) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), 23, 59, 59, 0, day.Location())
+}
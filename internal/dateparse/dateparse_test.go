@@ -0,0 +1,63 @@
+package dateparse
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("no tzdata: %v", err)
+	}
+
+	// Friday 16 October 2026, 10:30 in Berlin.
+	now := time.Date(2026, 10, 16, 10, 30, 0, 0, berlin)
+
+	tests := map[string]time.Time{
+		"2026-10-20T17:00:00Z": time.Date(2026, 10, 20, 17, 0, 0, 0, time.UTC),
+		"2026-10-20 17:00":     time.Date(2026, 10, 20, 17, 0, 0, 0, berlin),
+		"2026-10-20":           time.Date(2026, 10, 20, 0, 0, 0, 0, berlin),
+		"1760659200000":        time.UnixMilli(1760659200000),
+		"now":                  now,
+		"today":                time.Date(2026, 10, 16, 0, 0, 0, 0, berlin),
+		"Tomorrow":             time.Date(2026, 10, 17, 0, 0, 0, 0, berlin),
+		"next friday":          time.Date(2026, 10, 23, 0, 0, 0, 0, berlin),
+		"this friday":          time.Date(2026, 10, 16, 0, 0, 0, 0, berlin),
+		"mon":                  time.Date(2026, 10, 19, 0, 0, 0, 0, berlin),
+		"eod":                  time.Date(2026, 10, 16, 23, 59, 59, 0, berlin),
+		"eow":                  time.Date(2026, 10, 18, 23, 59, 59, 0, berlin),
+		"eom":                  time.Date(2026, 10, 31, 23, 59, 59, 0, berlin),
+		"+3d":                  time.Date(2026, 10, 19, 10, 30, 0, 0, berlin),
+		"-2h":                  now.Add(-2 * time.Hour),
+		"90m":                  now.Add(90 * time.Minute),
+		"+1w":                  time.Date(2026, 10, 23, 10, 30, 0, 0, berlin),
+		"in 2 days":            time.Date(2026, 10, 18, 10, 30, 0, 0, berlin),
+		"3 hours ago":          now.Add(-3 * time.Hour),
+	}
+
+	for input, want := range tests {
+		got, err := Parse(input, now)
+		if err != nil {
+			t.Errorf("%q: %v", input, err)
+			continue
+		}
+
+		if !got.Equal(want) {
+			t.Errorf("%q: got %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{"", "someday", "+3 fortnights", "2026-13-01"} {
+		if _, err := Parse(input, time.Now()); !errors.Is(err, ErrInvalid) {
+			t.Errorf("%q: expected ErrInvalid, got %v", input, err)
+		}
+	}
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/99designs/keyring"
 	"github.com/alecthomas/kong"
+
+	"github.com/builtbyrobben/clickup-cli/internal/api"
 )
 
 func Format(err error) string {
@@ -33,9 +35,55 @@ func Format(err error) string {
 		return userErr.Message
 	}
 
+	var apiErr *api.APIError
+	if errors.As(err, &apiErr) {
+		return formatAPIError(err, apiErr)
+	}
+
 	return err.Error()
 }
 
+// formatAPIError appends an actionable hint and the request ID (if any) to
+// API failures.
+func formatAPIError(err error, apiErr *api.APIError) string {
+	var b strings.Builder
+
+	b.WriteString(err.Error())
+
+	if hint := apiErrorHint(apiErr); hint != "" {
+		b.WriteString("\nHint: ")
+		b.WriteString(hint)
+	}
+
+	if apiErr.RequestID != "" {
+		b.WriteString("\nRequest ID: ")
+		b.WriteString(apiErr.RequestID)
+	}
+
+	return b.String()
+}
+
+func apiErrorHint(apiErr *api.APIError) string {
+	switch {
+	case errors.Is(apiErr, api.ErrUnauthorized):
+		if strings.Contains(strings.ToLower(apiErr.Message), "team") {
+			return "the token has no access to this workspace; check <cli> auth set-team and --workspace"
+		}
+
+		return "the API token is missing, invalid or expired; run: <cli> auth status, then <cli> auth set-key --stdin"
+	case errors.Is(apiErr, api.ErrForbidden):
+		return "your account lacks permission for this resource; ask a workspace admin for access"
+	case errors.Is(apiErr, api.ErrNotFound):
+		return "check the ID, and that it belongs to the configured workspace"
+	case errors.Is(apiErr, api.ErrRateLimited):
+		return "rate limit still exceeded after retries; wait a minute or raise --retries / --retry-max-wait"
+	case errors.Is(apiErr, api.ErrPlanLimit):
+		return "this feature or usage limit is not available on the workspace's ClickUp plan"
+	default:
+		return ""
+	}
+}
+
 // UserFacingError forces a specific message, while preserving the underlying cause.
 type UserFacingError struct {
 	Message string
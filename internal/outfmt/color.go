@@ -0,0 +1,49 @@
+package outfmt
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// ParseColor resolves a --color setting. auto enables colour only when
+// stdout is a terminal and NO_COLOR is unset.
+func ParseColor(setting string) (bool, error) {
+	switch strings.TrimSpace(strings.ToLower(setting)) {
+	case "", "auto":
+		if _, ok := os.LookupEnv("NO_COLOR"); ok {
+			return false, nil
+		}
+
+		return term.IsTerminal(int(os.Stdout.Fd())), nil
+	case "always":
+		return true, nil
+	case "never":
+		return false, nil
+	default:
+		return false, &ParseError{msg: fmt.Sprintf("invalid color mode %q (expected auto|always|never)", setting)}
+	}
+}
+
+// Colorize wraps s in a 24-bit ANSI foreground colour taken from a ClickUp
+// hex colour ("#d33d44"). Invalid or empty colours leave s unchanged.
+func Colorize(s string, hex string) string {
+	hex = strings.TrimPrefix(strings.TrimSpace(hex), "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+
+	if len(hex) != 6 || s == "" {
+		return s
+	}
+
+	rgb, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return s
+	}
+
+	return fmt.Sprintf("\x1b[38;2;%d;%d;%dm%s\x1b[0m", rgb>>16&0xff, rgb>>8&0xff, rgb&0xff, s)
+}
@@ -0,0 +1,163 @@
+package outfmt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/itchyny/gojq"
+)
+
+// ParseQuery compiles a jq expression for --query.
+func ParseQuery(expr string) (*gojq.Code, error) {
+	query, err := gojq.Parse(expr)
+	if err != nil {
+		return nil, &ParseError{msg: fmt.Sprintf("invalid --query: %v", err)}
+	}
+
+	code, err := gojq.Compile(query)
+	if err != nil {
+		return nil, &ParseError{msg: fmt.Sprintf("invalid --query: %v", err)}
+	}
+
+	return code, nil
+}
+
+// ParseFields splits a --fields list ("id,name,status.status") into dotted
+// paths, dropping empty entries.
+func ParseFields(s string) []string {
+	var fields []string
+
+	for _, f := range strings.Split(s, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+func (m Mode) filtered() bool {
+	return m.Query != nil || len(m.Fields) > 0 || m.Humanize
+}
+
+// decode round-trips v through JSON into maps, slices and scalars, the shape
+// projection and gojq operate on.
+func decode(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("encode json: %w", err)
+	}
+
+	var out any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("decode json: %w", err)
+	}
+
+	return out, nil
+}
+
+// apply runs --humanize, --fields and then --query over a decoded value and
+// returns the values to print. With inList set, --fields projects the
+// elements of a list response rather than the wrapper object.
+func (m Mode) apply(v any, inList bool) ([]any, error) {
+	if m.Humanize {
+		v = humanizeTimes(v, m.Time, time.Now())
+	}
+
+	if len(m.Fields) > 0 {
+		v = projectValue(v, m.Fields, inList)
+	}
+
+	if m.Query == nil {
+		return []any{v}, nil
+	}
+
+	var results []any
+
+	iter := m.Query.Run(v)
+
+	for {
+		result, ok := iter.Next()
+		if !ok {
+			break
+		}
+
+		if err, isErr := result.(error); isErr {
+			var halt *gojq.HaltError
+			if errors.As(err, &halt) && halt.Value() == nil {
+				break
+			}
+
+			return nil, fmt.Errorf("query: %w", err)
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func projectValue(v any, fields []string, inList bool) any {
+	switch val := v.(type) {
+	case []any:
+		out := make([]any, len(val))
+		for i, item := range val {
+			out[i] = projectValue(item, fields, false)
+		}
+
+		return out
+	case map[string]any:
+		if inList {
+			if key, ok := envelopeField(val); ok {
+				out := make(map[string]any, len(val))
+				for k, item := range val {
+					out[k] = item
+				}
+
+				out[key] = projectValue(val[key], fields, false)
+
+				return out
+			}
+		}
+
+		return projectObject(val, fields)
+	default:
+		return v
+	}
+}
+
+// projectObject keeps only the given dotted paths. Paths through arrays
+// apply to every element, so "assignees.username" keeps each assignee's
+// username.
+func projectObject(m map[string]any, fields []string) map[string]any {
+	// Group subpaths by their first segment; "" marks a bare key, which
+	// keeps the whole value.
+	nested := map[string][]string{}
+
+	for _, f := range fields {
+		head, rest, _ := strings.Cut(f, ".")
+		nested[head] = append(nested[head], rest)
+	}
+
+	out := map[string]any{}
+
+	for head, subpaths := range nested {
+		v, ok := m[head]
+		if !ok {
+			continue
+		}
+
+		if slices.Contains(subpaths, "") {
+			out[head] = v
+			continue
+		}
+
+		out[head] = projectValue(v, subpaths, false)
+	}
+
+	return out
+}
@@ -0,0 +1,88 @@
+package outfmt
+
+import (
+	"bytes"
+	"context"
+	"testing"
+)
+
+func TestWriteJSON_FieldsProjectListItems(t *testing.T) {
+	t.Parallel()
+
+	ctx := WithMode(context.Background(), Mode{
+		Format: FormatNDJSON,
+		Fields: ParseFields("id, status.status,assignees.username"),
+	})
+
+	resp := map[string]any{
+		"last_page": true,
+		"tasks": []map[string]any{{
+			"id":        "abc",
+			"name":      "Write docs",
+			"status":    map[string]any{"status": "open", "color": "#fff"},
+			"assignees": []map[string]any{{"id": 1, "username": "ana"}},
+		}},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteJSON(ctx, &buf, resp); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+
+	want := `{"assignees":[{"username":"ana"}],"id":"abc","status":{"status":"open"}}` + "\n"
+	if got := buf.String(); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestWriteJSON_FieldsProjectSingleObjectWithList(t *testing.T) {
+	t.Parallel()
+
+	ctx := WithMode(context.Background(), Mode{Format: FormatJSON, Fields: ParseFields("id,name")})
+
+	group := map[string]any{
+		"id":      "g1",
+		"name":    "Eng",
+		"members": []map[string]any{{"id": 1, "username": "ana"}},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteJSON(ctx, &buf, group); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+
+	want := "{\n  \"id\": \"g1\",\n  \"name\": \"Eng\"\n}\n"
+	if got := buf.String(); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestWriteJSON_Query(t *testing.T) {
+	t.Parallel()
+
+	code, err := ParseQuery(".tasks[] | select(.id == \"b\") | .name")
+	if err != nil {
+		t.Fatalf("ParseQuery: %v", err)
+	}
+
+	ctx := WithMode(context.Background(), Mode{Format: FormatJSON, Query: code})
+
+	resp := map[string]any{"tasks": []map[string]string{{"id": "a", "name": "A"}, {"id": "b", "name": "B"}}}
+
+	var buf bytes.Buffer
+	if err := WriteJSON(ctx, &buf, resp); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+
+	if got := buf.String(); got != "\"B\"\n" {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestParseQuery_Invalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := ParseQuery(".["); err == nil {
+		t.Fatal("expected parse error")
+	}
+}
@@ -7,30 +7,125 @@ import (
 	"io"
 	"os"
 	"strings"
+	"text/template"
+
+	"github.com/itchyny/gojq"
 )
 
+// Format is an output format selected with --output.
+type Format string
+
+const (
+	FormatTable  Format = "table"
+	FormatJSON   Format = "json"
+	FormatNDJSON Format = "ndjson"
+	FormatCSV    Format = "csv"
+	FormatTSV    Format = "tsv"
+)
+
+// Formats lists the accepted --output values.
+var Formats = []Format{FormatJSON, FormatNDJSON, FormatCSV, FormatTSV, FormatTable}
+
 type Mode struct {
-	JSON  bool
-	Plain bool
+	Format Format
+	// Query is a compiled --query jq expression applied to JSON output.
+	Query *gojq.Code
+	// Fields are the --fields dotted paths kept in JSON output.
+	Fields []string
+	// Template is a --template rendered in place of JSON output.
+	Template *template.Template
+	// Color enables ANSI colours in human and template output.
+	Color bool
+	// Time is how timestamps render in human output (--time-format, --tz).
+	Time TimeStyle
+	// Humanize renders timestamps in json and plain output too.
+	Humanize bool
 }
 
 type ParseError struct{ msg string }
 
 func (e *ParseError) Error() string { return e.msg }
 
-func FromFlags(jsonOut bool, plainOut bool) (Mode, error) {
+// ParseFormat validates an --output value. An empty string means human
+// (table) output.
+func ParseFormat(s string) (Format, error) {
+	s = strings.TrimSpace(strings.ToLower(s))
+	if s == "" {
+		return FormatTable, nil
+	}
+
+	for _, f := range Formats {
+		if Format(s) == f {
+			return f, nil
+		}
+	}
+
+	return "", &ParseError{msg: fmt.Sprintf("invalid output format %q (expected json|ndjson|csv|tsv|table)", s)}
+}
+
+// FromFlags resolves --output and its --json/--plain shorthands. --json is
+// --output json and --plain is --output tsv; conflicting values are rejected.
+// The zero Mode means no output flag was given.
+func FromFlags(output string, jsonOut bool, plainOut bool) (Mode, error) {
 	if jsonOut && plainOut {
 		return Mode{}, &ParseError{msg: "invalid output mode (cannot combine --json and --plain)"}
 	}
 
-	return Mode{JSON: jsonOut, Plain: plainOut}, nil
+	var format Format
+
+	if output != "" {
+		f, err := ParseFormat(output)
+		if err != nil {
+			return Mode{}, err
+		}
+
+		format = f
+	}
+
+	shorthand := Format("")
+
+	switch {
+	case jsonOut:
+		shorthand = FormatJSON
+	case plainOut:
+		shorthand = FormatTSV
+	}
+
+	if shorthand != "" {
+		if format != "" && format != shorthand {
+			return Mode{}, &ParseError{msg: fmt.Sprintf("invalid output mode (cannot combine --output %s with --json or --plain)", format)}
+		}
+
+		format = shorthand
+	}
+
+	return Mode{Format: format}, nil
 }
 
-func FromEnv(envPrefix string) Mode {
-	return Mode{
-		JSON:  envBool(envPrefix + "_JSON"),
-		Plain: envBool(envPrefix + "_PLAIN"),
+// FromEnv reads PREFIX_OUTPUT, falling back to the PREFIX_JSON and
+// PREFIX_PLAIN booleans.
+func FromEnv(envPrefix string) (Mode, error) {
+	if v := os.Getenv(envPrefix + "_OUTPUT"); strings.TrimSpace(v) != "" {
+		f, err := ParseFormat(v)
+		if err != nil {
+			return Mode{}, fmt.Errorf("%s_OUTPUT: %w", envPrefix, err)
+		}
+
+		return Mode{Format: f}, nil
 	}
+
+	return FromFlags("", envBool(envPrefix+"_JSON"), envBool(envPrefix+"_PLAIN"))
+}
+
+// Resolve picks the output mode: the flags when any output flag was given,
+// the PREFIX_ environment variables otherwise.
+func Resolve(output string, jsonOut, plainOut bool, envPrefix string) (Mode, error) {
+	mode, err := FromFlags(output, jsonOut, plainOut)
+	if err != nil || mode.Format != "" {
+		return mode, err
+	}
+
+	return FromEnv(envPrefix)
 }
 
 type ctxKey struct{}
@@ -49,45 +144,126 @@ func FromContext(ctx context.Context) Mode {
 	return Mode{}
 }
 
-func IsJSON(ctx context.Context) bool  { return FromContext(ctx).JSON }
-func IsPlain(ctx context.Context) bool { return FromContext(ctx).Plain }
+// IsJSON reports whether commands should emit whole objects (json or ndjson).
+func IsJSON(ctx context.Context) bool {
+	f := FromContext(ctx).Format
+	return f == FormatJSON || f == FormatNDJSON
+}
+
+// IsNDJSON reports whether objects should be written one per line.
+func IsNDJSON(ctx context.Context) bool { return FromContext(ctx).Format == FormatNDJSON }
+
+// IsPlain reports whether commands should emit rows (csv or tsv).
+func IsPlain(ctx context.Context) bool {
+	f := FromContext(ctx).Format
+	return f == FormatCSV || f == FormatTSV
+}
+
+// WriteJSON writes v in the active JSON format: indented for json, one
+// compact object per line for ndjson. Arrays and list responses (see
+// envelopeField) are written one element per line in ndjson. --fields and --query are applied before encoding; in ndjson they
+// run on each line. With --template, v is rendered by the template instead.
+func WriteJSON(ctx context.Context, w io.Writer, v any) error {
+	mode := FromContext(ctx)
+
+	if mode.Template != nil {
+		return executeTemplate(w, mode.Template, v)
+	}
+
+	if mode.Format == FormatNDJSON {
+		decoded, err := decode(v)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range ndjsonItems(decoded) {
+			if err := WriteJSONLine(ctx, w, item); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	results := []any{v}
+
+	if mode.filtered() {
+		decoded, err := decode(v)
+		if err != nil {
+			return err
+		}
+
+		results, err = mode.apply(decoded, true)
+		if err != nil {
+			return err
+		}
+	}
 
-func WriteJSON(w io.Writer, v any) error {
 	enc := json.NewEncoder(w)
 	enc.SetEscapeHTML(false)
 	enc.SetIndent("", "  ")
 
-	if err := enc.Encode(v); err != nil {
-		return fmt.Errorf("encode json: %w", err)
+	for _, result := range results {
+		if err := enc.Encode(result); err != nil {
+			return fmt.Errorf("encode json: %w", err)
+		}
 	}
 
 	return nil
 }
 
-// WritePlain writes tab-separated values to the writer.
-func WritePlain(w io.Writer, headers []string, rows [][]string) error {
-	replacer := strings.NewReplacer("\t", " ", "\n", " ", "\r", "")
+// WriteJSONLine writes v as a single compact line after applying --fields
+// and --query. Streaming commands use it to emit ndjson as pages arrive.
+func WriteJSONLine(ctx context.Context, w io.Writer, v any) error {
+	mode := FromContext(ctx)
 
-	if len(headers) > 0 {
-		if _, err := fmt.Fprintln(w, strings.Join(headers, "\t")); err != nil {
-			return fmt.Errorf("write plain headers: %w", err)
-		}
+	if mode.Template != nil {
+		return executeTemplate(w, mode.Template, v)
 	}
 
-	for _, row := range rows {
-		cleaned := make([]string, len(row))
-		for i, cell := range row {
-			cleaned[i] = replacer.Replace(cell)
+	results := []any{v}
+
+	if mode.filtered() {
+		decoded, err := decode(v)
+		if err != nil {
+			return err
 		}
 
-		if _, err := fmt.Fprintln(w, strings.Join(cleaned, "\t")); err != nil {
-			return fmt.Errorf("write plain row: %w", err)
+		results, err = mode.apply(decoded, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, result := range results {
+		if err := writeLine(w, result); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// WritePlain writes headers and rows in the active row format: csv, tsv or an
+// aligned table for human output.
+func WritePlain(ctx context.Context, w io.Writer, headers []string, rows [][]string) error {
+	rw := NewWriter(ctx, w)
+
+	if len(headers) > 0 {
+		if err := rw.WriteHeader(headers); err != nil {
+			return err
+		}
+	}
+
+	for _, row := range rows {
+		if err := rw.WriteRow(row); err != nil {
+			return err
+		}
+	}
+
+	return rw.Flush()
+}
+
 func KeyValuePayload(key string, value any) map[string]any {
 	return map[string]any{
 		"key":   key,
@@ -0,0 +1,219 @@
+package outfmt
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/term"
+)
+
+// Cell is a row value with an optional ClickUp hex colour ("#d33d44"). Only
+// table output renders the colour; other formats write the text.
+type Cell struct {
+	Text  string
+	Color string
+}
+
+// CellWriter is implemented by writers that render cell colours.
+type CellWriter interface {
+	WriteCells(cells []Cell) error
+}
+
+// WriteCells writes a row of cells to w, dropping colours unless w renders
+// them.
+func WriteCells(w Writer, cells []Cell) error {
+	if cw, ok := w.(CellWriter); ok {
+		return cw.WriteCells(cells)
+	}
+
+	texts := make([]string, len(cells))
+	for i, c := range cells {
+		texts[i] = c.Text
+	}
+
+	return w.WriteRow(texts)
+}
+
+// WriteRows writes headers and coloured rows in the active row format.
+func WriteRows(ctx context.Context, w io.Writer, headers []string, rows [][]Cell) error {
+	rw := NewWriter(ctx, w)
+
+	if err := rw.WriteHeader(headers); err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		if err := WriteCells(rw, row); err != nil {
+			return err
+		}
+	}
+
+	return rw.Flush()
+}
+
+const (
+	tableGap       = 2
+	tableMinColumn = 6
+)
+
+// tableWriter aligns rows into space-padded columns. Rows are buffered until
+// Flush so column widths fit every row; when maxWidth is set, the widest
+// columns are truncated until the table fits.
+type tableWriter struct {
+	w        io.Writer
+	color    bool
+	maxWidth int
+	headers  []string
+	rows     [][]Cell
+}
+
+func newTableWriter(w io.Writer, color bool) *tableWriter {
+	t := &tableWriter{w: w, color: color}
+
+	if f, ok := w.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		if width, _, err := term.GetSize(int(f.Fd())); err == nil {
+			t.maxWidth = width
+		}
+	}
+
+	return t
+}
+
+func (t *tableWriter) WriteHeader(headers []string) error {
+	t.headers = headers
+	return nil
+}
+
+func (t *tableWriter) WriteRow(cells []string) error {
+	row := make([]Cell, len(cells))
+	for i, text := range cells {
+		row[i] = Cell{Text: text}
+	}
+
+	return t.WriteCells(row)
+}
+
+func (t *tableWriter) WriteCells(cells []Cell) error {
+	row := make([]Cell, len(cells))
+	for i, c := range cells {
+		row[i] = Cell{Text: tsvReplacer.Replace(c.Text), Color: c.Color}
+	}
+
+	t.rows = append(t.rows, row)
+
+	return nil
+}
+
+// Flush writes the buffered table. A table without rows writes nothing.
+func (t *tableWriter) Flush() error {
+	if len(t.rows) == 0 {
+		return nil
+	}
+
+	widths := t.columnWidths()
+
+	var b strings.Builder
+
+	if len(t.headers) > 0 {
+		header := make([]Cell, len(t.headers))
+		for i, h := range t.headers {
+			header[i] = Cell{Text: h}
+		}
+
+		t.writeLine(&b, header, widths, true)
+	}
+
+	for _, row := range t.rows {
+		t.writeLine(&b, row, widths, false)
+	}
+
+	t.rows = nil
+
+	if _, err := io.WriteString(t.w, b.String()); err != nil {
+		return fmt.Errorf("write table: %w", err)
+	}
+
+	return nil
+}
+
+func (t *tableWriter) columnWidths() []int {
+	n := len(t.headers)
+	for _, row := range t.rows {
+		n = max(n, len(row))
+	}
+
+	widths := make([]int, n)
+
+	for i, h := range t.headers {
+		widths[i] = utf8.RuneCountInString(h)
+	}
+
+	for _, row := range t.rows {
+		for i, c := range row {
+			widths[i] = max(widths[i], utf8.RuneCountInString(c.Text))
+		}
+	}
+
+	if t.maxWidth <= 0 {
+		return widths
+	}
+
+	total := tableGap * (n - 1)
+	for _, w := range widths {
+		total += w
+	}
+
+	for total > t.maxWidth {
+		widest := 0
+		for i, w := range widths {
+			if w > widths[widest] {
+				widest = i
+			}
+		}
+
+		if widths[widest] <= tableMinColumn {
+			break
+		}
+
+		widths[widest]--
+		total--
+	}
+
+	return widths
+}
+
+func (t *tableWriter) writeLine(b *strings.Builder, cells []Cell, widths []int, header bool) {
+	var line strings.Builder
+
+	for i, width := range widths {
+		var c Cell
+		if i < len(cells) {
+			c = cells[i]
+		}
+
+		text := truncate(width, c.Text)
+		fill := width - utf8.RuneCountInString(text)
+
+		switch {
+		case !t.color:
+		case header:
+			text = "\x1b[1m" + text + "\x1b[0m"
+		case c.Color != "":
+			text = Colorize(text, c.Color)
+		}
+
+		line.WriteString(text)
+
+		if i < len(widths)-1 {
+			line.WriteString(strings.Repeat(" ", fill+tableGap))
+		}
+	}
+
+	// Empty trailing cells would otherwise leave padding at the end.
+	b.WriteString(strings.TrimRight(line.String(), " "))
+	b.WriteByte('\n')
+}
@@ -0,0 +1,64 @@
+package outfmt
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestTableWriter_AlignsAndTruncates(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	tw := &tableWriter{w: &buf, maxWidth: 24}
+
+	_ = tw.WriteHeader([]string{"ID", "NAME", "STATUS"})
+	_ = tw.WriteCells([]Cell{{Text: "abc"}, {Text: "A rather long task name"}, {Text: "open", Color: "#d3d3d3"}})
+	_ = tw.WriteRow([]string{"d", "Short", "in progress"})
+
+	if err := tw.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	want := "" +
+		"ID   NAME      STATUS\n" +
+		"abc  A rathe…  open\n" +
+		"d    Short     in progr…\n"
+	if got := buf.String(); got != want {
+		t.Fatalf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestTableWriter_Colour(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	tw := &tableWriter{w: &buf, color: true}
+
+	_ = tw.WriteHeader([]string{"ID", "STATUS"})
+	_ = tw.WriteCells([]Cell{{Text: "a"}, {Text: "open", Color: "#ff0000"}})
+
+	if err := tw.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	want := "\x1b[1mID\x1b[0m  \x1b[1mSTATUS\x1b[0m\n" +
+		"a   \x1b[38;2;255;0;0mopen\x1b[0m\n"
+	if got := buf.String(); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestTableWriter_EmptyWritesNothing(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	tw := &tableWriter{w: &buf}
+	_ = tw.WriteHeader([]string{"ID"})
+
+	if err := tw.Flush(); err != nil || buf.Len() != 0 {
+		t.Fatalf("got %q, %v", buf.String(), err)
+	}
+}
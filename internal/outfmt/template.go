@@ -0,0 +1,295 @@
+package outfmt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"text/template"
+	"time"
+	"unicode/utf8"
+)
+
+// ParseTemplate compiles a --template value. A leading "@" reads the template
+// from a file. The helpers follow mode's colour and time settings.
+func ParseTemplate(s string, mode Mode) (*template.Template, error) {
+	src := s
+
+	if path, ok := strings.CutPrefix(s, "@"); ok {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read --template file: %w", err)
+		}
+
+		src = string(data)
+	}
+
+	tmpl, err := template.New("output").Funcs(templateFuncs(mode)).Parse(src)
+	if err != nil {
+		return nil, &ParseError{msg: fmt.Sprintf("invalid --template: %v", err)}
+	}
+
+	return tmpl, nil
+}
+
+// executeTemplate renders v with tmpl. Slices and list responses (see
+// envelopeField) are rendered once per element; each rendering ends with a
+// newline.
+func executeTemplate(w io.Writer, tmpl *template.Template, v any) error {
+	for _, item := range templateItems(reflect.ValueOf(v)) {
+		var buf bytes.Buffer
+
+		if err := tmpl.Execute(&buf, item); err != nil {
+			return fmt.Errorf("execute template: %w", err)
+		}
+
+		if !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+			buf.WriteByte('\n')
+		}
+
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return fmt.Errorf("write template output: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func templateItems(v reflect.Value) []any {
+	v = indirect(v)
+	if !v.IsValid() {
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		return sliceItems(v)
+	case reflect.Struct:
+		t := v.Type()
+		fields := map[string]int{}
+		keys := make([]string, 0, t.NumField())
+
+		for i := range t.NumField() {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+
+			name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if name == "-" {
+				continue
+			}
+
+			if name == "" {
+				name = f.Name
+			}
+
+			fields[name] = i
+			keys = append(keys, name)
+		}
+
+		key, ok := envelopeKey(keys, func(key string) bool {
+			ft := t.Field(fields[key]).Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+
+			return ft.Kind() == reflect.Slice
+		})
+		if ok {
+			list := indirect(v.Field(fields[key]))
+			if !list.IsValid() {
+				return nil
+			}
+
+			return sliceItems(list)
+		}
+	case reflect.Map:
+		if m, ok := v.Interface().(map[string]any); ok {
+			if key, ok := envelopeField(m); ok {
+				items, _ := m[key].([]any)
+				return items
+			}
+		}
+	default:
+	}
+
+	return []any{v.Interface()}
+}
+
+func sliceItems(v reflect.Value) []any {
+	items := make([]any, v.Len())
+	for i := range items {
+		items[i] = v.Index(i).Interface()
+	}
+
+	return items
+}
+
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+
+		v = v.Elem()
+	}
+
+	return v
+}
+
+func templateFuncs(mode Mode) template.FuncMap {
+	colorize := func(hex string, s any) string {
+		if !mode.Color {
+			return text(s)
+		}
+
+		return Colorize(text(s), hex)
+	}
+
+	return template.FuncMap{
+		// Timestamps: ClickUp sends milliseconds since the epoch, as strings
+		// or numbers. humanDate follows --time-format and --tz.
+		"humanDate": func(ts any) string { return formatMillis(ts, mode.Time) },
+		"relDate":   func(ts any) string { return formatMillis(ts, TimeStyle{Relative: true}) },
+		"date": func(layout string, ts any) string {
+			return formatMillis(ts, TimeStyle{Location: mode.Time.Location, Layout: layout})
+		},
+
+		// Colours take ClickUp hex colours; they are dropped when colour is off.
+		"color": colorize,
+		"priority": func(p any) string {
+			return colorize(text(field(p, "Color")), field(p, "Name"))
+		},
+		"status": func(s any) string {
+			return colorize(text(field(s, "Color")), field(s, "Status"))
+		},
+
+		// Lists.
+		"pluck":     pluck,
+		"join":      join,
+		"usernames": func(users any) string { return join(", ", pluck("Username", users)) },
+
+		// Text.
+		"truncate": truncate,
+		"pad":      func(n int, s any) string { return pad(n, text(s), false) },
+		"padLeft":  func(n int, s any) string { return pad(n, text(s), true) },
+		"upper":    func(s any) string { return strings.ToUpper(text(s)) },
+		"lower":    func(s any) string { return strings.ToLower(text(s)) },
+		"json": func(v any) (string, error) {
+			data, err := json.Marshal(v)
+			return string(data), err
+		},
+	}
+}
+
+func text(v any) string {
+	rv := indirect(reflect.ValueOf(v))
+	if !rv.IsValid() {
+		return ""
+	}
+
+	return fmt.Sprint(rv.Interface())
+}
+
+// field returns the named struct field or map key of v, or nil.
+func field(v any, name string) any {
+	rv := indirect(reflect.ValueOf(v))
+
+	switch rv.Kind() {
+	case reflect.Struct:
+		if f := rv.FieldByName(name); f.IsValid() && f.CanInterface() {
+			return f.Interface()
+		}
+	case reflect.Map:
+		if rv.Type().Key().Kind() == reflect.String {
+			if f := rv.MapIndex(reflect.ValueOf(name).Convert(rv.Type().Key())); f.IsValid() {
+				return f.Interface()
+			}
+		}
+	default:
+	}
+
+	return nil
+}
+
+// pluck returns the named field of every element of list.
+func pluck(name string, list any) []any {
+	rv := indirect(reflect.ValueOf(list))
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil
+	}
+
+	out := make([]any, 0, rv.Len())
+	for i := range rv.Len() {
+		out = append(out, field(rv.Index(i).Interface(), name))
+	}
+
+	return out
+}
+
+func join(sep string, list any) string {
+	rv := indirect(reflect.ValueOf(list))
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return text(list)
+	}
+
+	parts := make([]string, 0, rv.Len())
+	for i := range rv.Len() {
+		if s := text(rv.Index(i).Interface()); s != "" {
+			parts = append(parts, s)
+		}
+	}
+
+	return strings.Join(parts, sep)
+}
+
+func truncate(n int, v any) string {
+	s := text(v)
+	if n <= 0 || utf8.RuneCountInString(s) <= n {
+		return s
+	}
+
+	runes := []rune(s)
+	if n == 1 {
+		return "…"
+	}
+
+	return string(runes[:n-1]) + "…"
+}
+
+func pad(n int, s string, left bool) string {
+	fill := n - utf8.RuneCountInString(s)
+	if fill <= 0 {
+		return s
+	}
+
+	if left {
+		return strings.Repeat(" ", fill) + s
+	}
+
+	return s + strings.Repeat(" ", fill)
+}
+
+// formatMillis formats a millisecond epoch in style. Empty and zero values
+// format as ""; values that are not timestamps are returned as text.
+func formatMillis(ts any, style TimeStyle) string {
+	rv := indirect(reflect.ValueOf(ts))
+	if !rv.IsValid() {
+		return ""
+	}
+
+	ms, ok := parseMillis(rv.Interface())
+	if !ok {
+		return text(ts)
+	}
+
+	if ms == 0 {
+		return ""
+	}
+
+	return style.Format(time.UnixMilli(ms), time.Now())
+}
@@ -0,0 +1,107 @@
+package outfmt
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type testUser struct{ Username string }
+
+type testPriority struct{ Name, Color string }
+
+type testTask struct {
+	ID        string
+	Name      string
+	DueDate   string
+	Priority  *testPriority
+	Assignees []testUser
+}
+
+type testTasksResponse struct {
+	Tasks    []testTask
+	LastPage bool
+}
+
+func TestWriteJSON_TemplatePerListItem(t *testing.T) {
+	t.Parallel()
+
+	tmpl, err := ParseTemplate(`{{.ID}} {{.Name | truncate 6}} {{.Assignees | usernames}} {{.Priority | priority}} {{.DueDate | date "2006-01-02"}}`, Mode{})
+	if err != nil {
+		t.Fatalf("ParseTemplate: %v", err)
+	}
+
+	due := time.Date(2026, 3, 14, 12, 0, 0, 0, time.Local).UnixMilli()
+
+	resp := testTasksResponse{Tasks: []testTask{
+		{ID: "a", Name: "Write the docs", DueDate: strconv.FormatInt(due, 10), Priority: &testPriority{Name: "high", Color: "#f50000"},
+			Assignees: []testUser{{Username: "ana"}, {Username: "bo"}}},
+		{ID: "b", Name: "Ship"},
+	}}
+
+	var buf bytes.Buffer
+
+	ctx := WithMode(context.Background(), Mode{Format: FormatJSON, Template: tmpl})
+	if err := WriteJSON(ctx, &buf, resp); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+
+	want := "a Write… ana, bo high 2026-03-14\nb Ship   \n"
+	if got := buf.String(); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestWriteJSON_TemplateOnceForSingleObjectWithList(t *testing.T) {
+	t.Parallel()
+
+	type group struct {
+		ID      string     `json:"id"`
+		Name    string     `json:"name"`
+		Members []testUser `json:"members"`
+	}
+
+	tmpl, err := ParseTemplate(`{{.Name}}: {{len .Members}}`, Mode{})
+	if err != nil {
+		t.Fatalf("ParseTemplate: %v", err)
+	}
+
+	var buf bytes.Buffer
+
+	ctx := WithMode(context.Background(), Mode{Format: FormatJSON, Template: tmpl})
+	if err := WriteJSON(ctx, &buf, group{ID: "g1", Name: "Eng", Members: []testUser{{Username: "ana"}, {Username: "bo"}}}); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+
+	if got := buf.String(); got != "Eng: 2\n" {
+		t.Fatalf("got %q, want %q", got, "Eng: 2\n")
+	}
+}
+
+func TestParseTemplate_File(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "row.tmpl")
+	if err := os.WriteFile(path, []byte(`{{pad 4 .ID}}|{{color "#00ff00" .Name}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl, err := ParseTemplate("@"+path, Mode{Color: true})
+	if err != nil {
+		t.Fatalf("ParseTemplate: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := executeTemplate(&buf, tmpl, testTask{ID: "a", Name: "x"}); err != nil {
+		t.Fatalf("executeTemplate: %v", err)
+	}
+
+	want := "a   |\x1b[38;2;0;255;0mx\x1b[0m\n"
+	if got := buf.String(); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,184 @@
+package outfmt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultTimeLayout is how timestamps render in human output by default.
+const DefaultTimeLayout = "2006-01-02 15:04"
+
+// TimeStyle controls how ClickUp millisecond timestamps are rendered.
+type TimeStyle struct {
+	// Location is the --tz time zone; nil means local time.
+	Location *time.Location
+	// Layout is a Go time layout, used unless Relative is set.
+	Layout string
+	// Relative renders timestamps relative to now ("in 2 days", "3h ago").
+	Relative bool
+}
+
+// ParseTimeStyle resolves --time-format and --tz. The format is absolute
+// (the default), relative, iso, or a Go time layout such as "Jan 2 15:04";
+// tz is an IANA zone name, UTC or Local.
+func ParseTimeStyle(format string, tz string) (TimeStyle, error) {
+	style := TimeStyle{Layout: DefaultTimeLayout}
+
+	if tz = strings.TrimSpace(tz); tz != "" {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return TimeStyle{}, &ParseError{msg: fmt.Sprintf("invalid --tz %q: %v", tz, err)}
+		}
+
+		style.Location = loc
+	}
+
+	switch strings.TrimSpace(format) {
+	case "", "absolute":
+	case "relative":
+		style.Relative = true
+	case "iso":
+		style.Layout = time.RFC3339
+	default:
+		style.Layout = format
+	}
+
+	return style, nil
+}
+
+// Format renders t in the style's zone and layout, or relative to now.
+func (s TimeStyle) Format(t time.Time, now time.Time) string {
+	if s.Relative {
+		return relativeTime(t.Sub(now))
+	}
+
+	loc := s.Location
+	if loc == nil {
+		loc = time.Local
+	}
+
+	layout := s.Layout
+	if layout == "" {
+		layout = DefaultTimeLayout
+	}
+
+	return t.In(loc).Format(layout)
+}
+
+// Timestamp renders a ClickUp millisecond timestamp (a string, json.Number
+// or integer) for output. Human and table output use the --time-format
+// style; json, ndjson, csv and tsv keep the raw value unless --humanize is
+// set. Empty and zero timestamps render as "".
+func Timestamp(ctx context.Context, v any) string {
+	raw := text(v)
+
+	ms, ok := parseMillis(v)
+	if !ok {
+		return raw
+	}
+
+	if ms == 0 {
+		return ""
+	}
+
+	mode := FromContext(ctx)
+	if (IsJSON(ctx) || IsPlain(ctx)) && !mode.Humanize {
+		return raw
+	}
+
+	return mode.Time.Format(time.UnixMilli(ms), time.Now())
+}
+
+// parseMillis reads a millisecond epoch from the shapes ClickUp sends.
+func parseMillis(v any) (int64, bool) {
+	switch val := v.(type) {
+	case int64:
+		return val, true
+	case int:
+		return int64(val), true
+	case float64:
+		return int64(val), true
+	case json.Number:
+		return parseMillis(string(val))
+	case string:
+		n, err := strconv.ParseInt(strings.TrimSpace(val), 10, 64)
+		return n, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func relativeTime(d time.Duration) string {
+	future := d > 0
+	d = d.Abs()
+
+	var span string
+
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		span = fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		span = fmt.Sprintf("%dh", int(d.Hours()))
+	case d < 30*24*time.Hour:
+		span = plural(int(math.Round(d.Hours()/24)), "day")
+	case d < 365*24*time.Hour:
+		span = plural(int(math.Round(d.Hours()/24/30)), "month")
+	default:
+		span = plural(int(math.Round(d.Hours()/24/365)), "year")
+	}
+
+	if future {
+		return "in " + span
+	}
+
+	return span + " ago"
+}
+
+func plural(n int, unit string) string {
+	if n == 1 {
+		return "1 " + unit
+	}
+
+	return fmt.Sprintf("%d %ss", n, unit)
+}
+
+// humanizeTimes replaces timestamp-valued fields in a decoded JSON value
+// with formatted times. Fields are recognised by name (date, start, end,
+// timestamp, date_* and *_date) and by holding a millisecond epoch.
+func humanizeTimes(v any, style TimeStyle, now time.Time) any {
+	switch val := v.(type) {
+	case []any:
+		for i, item := range val {
+			val[i] = humanizeTimes(item, style, now)
+		}
+	case map[string]any:
+		for k, item := range val {
+			if !timestampKey(k) {
+				val[k] = humanizeTimes(item, style, now)
+				continue
+			}
+
+			if ms, ok := parseMillis(item); ok && ms > 0 {
+				val[k] = style.Format(time.UnixMilli(ms), now)
+			}
+		}
+	}
+
+	return v
+}
+
+func timestampKey(k string) bool {
+	switch k {
+	case "date", "start", "end", "timestamp":
+		return true
+	}
+
+	return strings.HasPrefix(k, "date_") || strings.HasSuffix(k, "_date")
+}
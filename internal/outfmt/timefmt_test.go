@@ -0,0 +1,87 @@
+package outfmt
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimeStyle_Relative(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 14, 12, 0, 0, 0, time.UTC)
+	style := TimeStyle{Relative: true}
+
+	tests := map[time.Duration]string{
+		30 * time.Second:     "just now",
+		-3 * time.Hour:       "3h ago",
+		45 * time.Minute:     "in 45m",
+		48 * time.Hour:       "in 2 days",
+		-24 * time.Hour:      "1 day ago",
+		-90 * 24 * time.Hour: "3 months ago",
+	}
+
+	for d, want := range tests {
+		if got := style.Format(now.Add(d), now); got != want {
+			t.Errorf("%v: got %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestTimestamp_RawUnlessHuman(t *testing.T) {
+	t.Parallel()
+
+	style, err := ParseTimeStyle("iso", "UTC")
+	if err != nil {
+		t.Fatalf("ParseTimeStyle: %v", err)
+	}
+
+	ms := json.Number("1760659200000")
+
+	tests := []struct {
+		mode Mode
+		want string
+	}{
+		{Mode{Format: FormatTable, Time: style}, "2025-10-17T00:00:00Z"},
+		{Mode{Format: FormatTSV, Time: style}, "1760659200000"},
+		{Mode{Format: FormatTSV, Time: style, Humanize: true}, "2025-10-17T00:00:00Z"},
+		{Mode{Format: FormatJSON, Time: style}, "1760659200000"},
+	}
+
+	for _, tt := range tests {
+		ctx := WithMode(context.Background(), tt.mode)
+		if got := Timestamp(ctx, ms); got != tt.want {
+			t.Errorf("%s humanize=%t: got %q, want %q", tt.mode.Format, tt.mode.Humanize, got, tt.want)
+		}
+	}
+
+	if got := Timestamp(context.Background(), ""); got != "" {
+		t.Errorf("empty timestamp: got %q", got)
+	}
+}
+
+func TestHumanizeTimes(t *testing.T) {
+	t.Parallel()
+
+	style := TimeStyle{Location: time.UTC, Layout: time.DateOnly}
+
+	v := map[string]any{
+		"due_date": "1760659200000",
+		"points":   float64(1760659200000),
+		"intervals": []any{
+			map[string]any{"start": float64(1760659200000), "end": ""},
+		},
+	}
+
+	humanizeTimes(v, style, time.Now())
+
+	if v["due_date"] != "2025-10-17" || v["points"] != float64(1760659200000) {
+		t.Fatalf("got %v", v)
+	}
+
+	interval := v["intervals"].([]any)[0].(map[string]any)
+	if interval["start"] != "2025-10-17" || interval["end"] != "" {
+		t.Fatalf("got %v", interval)
+	}
+}
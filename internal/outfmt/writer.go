@@ -0,0 +1,246 @@
+package outfmt
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Writer renders a command's rows in any output format. Commands build
+// headers and string cells once; the writer for the active mode decides how
+// they are encoded. Flush must be called after the last row.
+type Writer interface {
+	WriteHeader(headers []string) error
+	WriteRow(cells []string) error
+	Flush() error
+}
+
+// NewWriter returns the row writer for the active output mode. In json and
+// ndjson modes each row becomes an object keyed by its lower-cased header; in
+// table mode rows are aligned, and coloured when colour is enabled.
+func NewWriter(ctx context.Context, w io.Writer) Writer {
+	mode := FromContext(ctx)
+
+	switch mode.Format {
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		cw.UseCRLF = true
+
+		return &csvWriter{w: cw}
+	case FormatJSON:
+		return &objectWriter{w: w}
+	case FormatNDJSON:
+		return &objectWriter{w: w, stream: true}
+	case FormatTable:
+		return newTableWriter(w, mode.Color)
+	default:
+		return &tsvWriter{w: w}
+	}
+}
+
+// tsvWriter writes tab-separated values, replacing tabs and newlines in cells
+// so every record stays on one line.
+type tsvWriter struct {
+	w io.Writer
+}
+
+var tsvReplacer = strings.NewReplacer("\t", " ", "\n", " ", "\r", "")
+
+func (t *tsvWriter) WriteHeader(headers []string) error {
+	if _, err := fmt.Fprintln(t.w, strings.Join(headers, "\t")); err != nil {
+		return fmt.Errorf("write plain headers: %w", err)
+	}
+
+	return nil
+}
+
+func (t *tsvWriter) WriteRow(cells []string) error {
+	cleaned := make([]string, len(cells))
+	for i, cell := range cells {
+		cleaned[i] = tsvReplacer.Replace(cell)
+	}
+
+	if _, err := fmt.Fprintln(t.w, strings.Join(cleaned, "\t")); err != nil {
+		return fmt.Errorf("write plain row: %w", err)
+	}
+
+	return nil
+}
+
+func (t *tsvWriter) Flush() error { return nil }
+
+// csvWriter writes RFC 4180 CSV: fields containing commas, quotes or line
+// breaks are quoted and records end in CRLF.
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) WriteHeader(headers []string) error { return c.WriteRow(headers) }
+
+func (c *csvWriter) WriteRow(cells []string) error {
+	if err := c.w.Write(cells); err != nil {
+		return fmt.Errorf("write csv row: %w", err)
+	}
+
+	return nil
+}
+
+func (c *csvWriter) Flush() error {
+	c.w.Flush()
+
+	if err := c.w.Error(); err != nil {
+		return fmt.Errorf("write csv: %w", err)
+	}
+
+	return nil
+}
+
+// objectWriter turns rows into JSON objects. When streaming (ndjson) each row
+// is written as it arrives; otherwise rows are collected into an array that
+// is written on Flush.
+type objectWriter struct {
+	w       io.Writer
+	stream  bool
+	headers []string
+	rows    []map[string]string
+}
+
+func (o *objectWriter) WriteHeader(headers []string) error {
+	o.headers = make([]string, len(headers))
+	for i, h := range headers {
+		o.headers[i] = strings.ToLower(h)
+	}
+
+	return nil
+}
+
+func (o *objectWriter) WriteRow(cells []string) error {
+	row := make(map[string]string, len(cells))
+
+	for i, cell := range cells {
+		key := fmt.Sprintf("col%d", i+1)
+		if i < len(o.headers) {
+			key = o.headers[i]
+		}
+
+		row[key] = cell
+	}
+
+	if o.stream {
+		return writeLine(o.w, row)
+	}
+
+	o.rows = append(o.rows, row)
+
+	return nil
+}
+
+func (o *objectWriter) Flush() error {
+	if o.stream {
+		return nil
+	}
+
+	if o.rows == nil {
+		o.rows = []map[string]string{}
+	}
+
+	enc := json.NewEncoder(o.w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(o.rows); err != nil {
+		return fmt.Errorf("encode json: %w", err)
+	}
+
+	return nil
+}
+
+func writeLine(w io.Writer, v any) error {
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("encode json: %w", err)
+	}
+
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("write ndjson: %w", err)
+	}
+
+	return nil
+}
+
+// ndjsonItems splits a decoded value into NDJSON lines. Arrays are split into
+// their elements, as are list responses (see envelopeField); anything else is
+// a single line.
+func ndjsonItems(v any) []any {
+	switch val := v.(type) {
+	case []any:
+		return val
+	case map[string]any:
+		if key, ok := envelopeField(val); ok {
+			items, _ := val[key].([]any)
+			return items
+		}
+	}
+
+	return []any{v}
+}
+
+// listEnvelopes are the array members that ClickUp list responses wrap their
+// items in, as in {"tasks": [...], "last_page": true}.
+var listEnvelopes = map[string]bool{
+	"attachments": true, "audit_logs": true, "channels": true, "comments": true,
+	"custom_items": true, "custom_roles": true, "data": true, "docs": true,
+	"fields": true, "folders": true, "goals": true, "groups": true,
+	"lists": true, "members": true, "pages": true, "reactions": true,
+	"spaces": true, "status_history": true, "subtypes": true, "tags": true,
+	"tasks": true, "teams": true, "templates": true, "users": true,
+	"views": true, "webhooks": true,
+}
+
+// envelopeField reports the array member of a list response: an object
+// without an ID whose only array member is one of listEnvelopes. A single
+// object that holds a list, such as a user group with its members, has an
+// ID and is left whole.
+func envelopeField(m map[string]any) (string, bool) {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	return envelopeKey(keys, func(key string) bool {
+		_, ok := m[key].([]any)
+		return ok
+	})
+}
+
+// envelopeKey applies the envelopeField rules to an object's member names;
+// isList reports which members are arrays.
+func envelopeKey(keys []string, isList func(key string) bool) (string, bool) {
+	list := ""
+
+	for _, key := range keys {
+		if strings.EqualFold(key, "id") {
+			return "", false
+		}
+
+		if !isList(key) {
+			continue
+		}
+
+		if list != "" {
+			return "", false
+		}
+
+		list = key
+	}
+
+	return list, list != "" && listEnvelopes[strings.ToLower(list)]
+}
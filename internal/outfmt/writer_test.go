@@ -0,0 +1,160 @@
+package outfmt
+
+import (
+	"bytes"
+	"context"
+	"testing"
+)
+
+func writeRows(t *testing.T, format Format, headers []string, rows ...[]string) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	w := NewWriter(WithMode(context.Background(), Mode{Format: format}), &buf)
+	if err := w.WriteHeader(headers); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, row := range rows {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.String()
+}
+
+func TestCSVWriter_QuotesAndKeepsNewlines(t *testing.T) {
+	t.Parallel()
+
+	got := writeRows(t, FormatCSV, []string{"ID", "NAME"}, []string{"a", `Say "hi", then
+leave`})
+
+	// Line breaks inside a quoted field become CRLF too.
+	want := "ID,NAME\r\na,\"Say \"\"hi\"\", then\r\nleave\"\r\n"
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestTSVWriter_FlattensCells(t *testing.T) {
+	t.Parallel()
+
+	got := writeRows(t, FormatTSV, []string{"ID", "NAME"}, []string{"a", "tab\there\nnext"})
+
+	if want := "ID\tNAME\na\ttab here next\n"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestWriteJSON_NDJSONSplitsOnlyListResponses(t *testing.T) {
+	t.Parallel()
+
+	type member struct {
+		Username string `json:"username"`
+	}
+
+	type group struct {
+		ID      string   `json:"id"`
+		Name    string   `json:"name"`
+		Members []member `json:"members"`
+	}
+
+	type membersResponse struct {
+		Members []member `json:"members"`
+	}
+
+	ctx := WithMode(context.Background(), Mode{Format: FormatNDJSON})
+	members := []member{{Username: "ana"}, {Username: "bo"}}
+
+	var buf bytes.Buffer
+	if err := WriteJSON(ctx, &buf, membersResponse{Members: members}); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "{\"username\":\"ana\"}\n{\"username\":\"bo\"}\n"; buf.String() != want {
+		t.Fatalf("list response: got %q, want %q", buf.String(), want)
+	}
+
+	buf.Reset()
+
+	if err := WriteJSON(ctx, &buf, group{ID: "g1", Name: "Eng", Members: members}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"id":"g1","members":[{"username":"ana"},{"username":"bo"}],"name":"Eng"}` + "\n"
+	if buf.String() != want {
+		t.Fatalf("single object: got %q, want %q", buf.String(), want)
+	}
+
+	buf.Reset()
+
+	// An array member that is not a known envelope is part of the object.
+	if err := WriteJSON(ctx, &buf, map[string]any{"name": "Checklist", "items": []string{"a", "b"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := `{"items":["a","b"],"name":"Checklist"}` + "\n"; buf.String() != want {
+		t.Fatalf("checklist: got %q, want %q", buf.String(), want)
+	}
+}
+
+func TestFromFlags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		output      string
+		json, plain bool
+		want        Format
+		wantErr     bool
+	}{
+		{want: ""},
+		{output: "CSV", want: FormatCSV},
+		{json: true, want: FormatJSON},
+		{plain: true, want: FormatTSV},
+		{output: "json", json: true, want: FormatJSON},
+		{output: "csv", json: true, wantErr: true},
+		{json: true, plain: true, wantErr: true},
+		{output: "xml", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		mode, err := FromFlags(tt.output, tt.json, tt.plain)
+		if (err != nil) != tt.wantErr || mode.Format != tt.want {
+			t.Errorf("FromFlags(%q, %v, %v) = %q, %v", tt.output, tt.json, tt.plain, mode.Format, err)
+		}
+	}
+}
+
+func TestResolve_FlagsBeatEnv(t *testing.T) {
+	t.Setenv("OUTFMT_TEST_OUTPUT", "csv")
+	t.Setenv("OUTFMT_TEST_JSON", "1")
+
+	mode, err := Resolve("", false, false, "OUTFMT_TEST")
+	if err != nil || mode.Format != FormatCSV {
+		t.Fatalf("expected PREFIX_OUTPUT to beat PREFIX_JSON, got %q, %v", mode.Format, err)
+	}
+
+	mode, err = Resolve("", false, true, "OUTFMT_TEST")
+	if err != nil || mode.Format != FormatTSV {
+		t.Fatalf("expected --plain to beat the environment, got %q, %v", mode.Format, err)
+	}
+
+	t.Setenv("OUTFMT_TEST_OUTPUT", "")
+
+	mode, err = Resolve("", false, false, "OUTFMT_TEST")
+	if err != nil || mode.Format != FormatJSON {
+		t.Fatalf("expected PREFIX_JSON, got %q, %v", mode.Format, err)
+	}
+
+	t.Setenv("OUTFMT_TEST_OUTPUT", "xml")
+
+	if _, err := Resolve("", false, false, "OUTFMT_TEST"); err == nil {
+		t.Fatal("expected an error for an invalid PREFIX_OUTPUT")
+	}
+}
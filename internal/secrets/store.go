@@ -19,14 +19,34 @@ type Store interface {
 	SetAPIKey(key string) error
 	DeleteAPIKey() error
 	HasKey() (bool, error)
+	GetCredential() (Credential, error)
+	SetOAuthToken(token string) error
+}
+
+// CredentialKind tells how a stored token must be presented to the API.
+type CredentialKind string
+
+const (
+	// CredentialAPIKey is a personal API token, sent as-is.
+	CredentialAPIKey CredentialKind = "api_key"
+	// CredentialOAuth is an OAuth access token, sent as a Bearer token.
+	CredentialOAuth CredentialKind = "oauth"
+)
+
+// Credential is the active stored token and its kind.
+type Credential struct {
+	Kind  CredentialKind
+	Token string
 }
 
 type KeyringStore struct {
-	ring keyring.Keyring
+	ring    keyring.Keyring
+	profile string
 }
 
 const (
 	apiKeyKey          = "api_key"
+	oauthTokenKey      = "oauth_token"              //nolint:gosec // keyring item name, not a credential
 	keyringPasswordEnv = "CLICKUP_CLI_KEYRING_PASS" //nolint:gosec // env var name, not a credential
 	keyringBackendEnv  = "CLICKUP_CLI_KEYRING_BACKEND"
 	keyringOpenTimeout = 5 * time.Second
@@ -34,6 +54,7 @@ const (
 
 var (
 	errMissingAPIKey         = errors.New("missing API key")
+	errMissingOAuthToken     = errors.New("missing OAuth token")
 	errMissingSecretKey      = errors.New("missing secret key")
 	errNoTTY                 = errors.New("no TTY available for keyring file backend password prompt")
 	errInvalidKeyringBackend = errors.New("invalid keyring backend")
@@ -172,22 +193,127 @@ func openKeyringWithTimeout(cfg keyring.Config, timeout time.Duration) (keyring.
 	}
 }
 
+// OpenDefault opens the credential store of the active profile.
 func OpenDefault() (Store, error) {
+	return OpenProfile(config.ActiveProfile())
+}
+
+// OpenProfile opens the credential store of a named profile. Credentials
+// stored before profiles existed are moved into the default profile.
+func OpenProfile(profile string) (Store, error) {
 	ring, err := openKeyring()
 	if err != nil {
 		return nil, err
 	}
 
-	return &KeyringStore{ring: ring}, nil
+	s := &KeyringStore{ring: ring, profile: profile}
+
+	if profile == config.DefaultProfile {
+		if err := s.migrateLegacy(); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// key scopes a keyring item name to the store's profile, e.g. "api_key@work".
+func (s *KeyringStore) key(name string) string {
+	profile := s.profile
+	if profile == "" {
+		profile = config.DefaultProfile
+	}
+
+	return name + "@" + profile
 }
 
+// migrateLegacy moves unscoped items from before profiles existed into this
+// profile, unless it already has its own, and records the profile in the
+// config file so that it is listed.
+func (s *KeyringStore) migrateLegacy() error {
+	moved := false
+
+	for _, name := range []string{apiKeyKey, oauthTokenKey} {
+		item, err := s.ring.Get(name)
+		if err != nil {
+			continue
+		}
+
+		if _, err := s.ring.Get(s.key(name)); err == nil {
+			continue
+		}
+
+		item.Key = s.key(name)
+		if err := s.ring.Set(item); err != nil {
+			return fmt.Errorf("migrate credential to profile %s: %w", s.profile, err)
+		}
+
+		if err := s.remove(name); err != nil {
+			return fmt.Errorf("remove migrated credential: %w", err)
+		}
+
+		moved = true
+	}
+
+	if moved {
+		if err := config.EnsureProfile(s.profile); err != nil {
+			return fmt.Errorf("record migrated profile: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetAPIKey returns the active token, whichever kind it is.
 func (s *KeyringStore) GetAPIKey() (string, error) {
-	item, err := s.ring.Get(apiKeyKey)
+	cred, err := s.GetCredential()
+	if err != nil {
+		return "", err
+	}
+
+	return cred.Token, nil
+}
+
+// GetCredential returns the active credential. Storing one kind replaces the
+// other, so at most one is present; OAuth wins if both somehow are.
+func (s *KeyringStore) GetCredential() (Credential, error) {
+	item, err := s.ring.Get(s.key(oauthTokenKey))
+	if err == nil {
+		return Credential{Kind: CredentialOAuth, Token: string(item.Data)}, nil
+	}
+
+	if !errors.Is(err, keyring.ErrKeyNotFound) {
+		return Credential{}, fmt.Errorf("read OAuth token: %w", err)
+	}
+
+	item, err = s.ring.Get(s.key(apiKeyKey))
 	if err != nil {
-		return "", fmt.Errorf("read API key: %w", err)
+		return Credential{}, fmt.Errorf("read API key: %w", err)
 	}
 
-	return string(item.Data), nil
+	return Credential{Kind: CredentialAPIKey, Token: string(item.Data)}, nil
+}
+
+// SetOAuthToken stores an OAuth access token as the active credential,
+// replacing any stored API key.
+func (s *KeyringStore) SetOAuthToken(token string) error {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return errMissingOAuthToken
+	}
+
+	if err := s.ring.Set(keyring.Item{
+		Key:  s.key(oauthTokenKey),
+		Data: []byte(token),
+	}); err != nil {
+		return fmt.Errorf("store OAuth token: %w", err)
+	}
+
+	if err := s.remove(s.key(apiKeyKey)); err != nil {
+		return fmt.Errorf("delete API key: %w", err)
+	}
+
+	return nil
 }
 
 func (s *KeyringStore) SetAPIKey(key string) error {
@@ -197,25 +323,44 @@ func (s *KeyringStore) SetAPIKey(key string) error {
 	}
 
 	if err := s.ring.Set(keyring.Item{
-		Key:  apiKeyKey,
+		Key:  s.key(apiKeyKey),
 		Data: []byte(key),
 	}); err != nil {
 		return fmt.Errorf("store API key: %w", err)
 	}
 
+	if err := s.remove(s.key(oauthTokenKey)); err != nil {
+		return fmt.Errorf("delete OAuth token: %w", err)
+	}
+
 	return nil
 }
 
+// DeleteAPIKey removes every stored credential.
 func (s *KeyringStore) DeleteAPIKey() error {
-	if err := s.ring.Remove(apiKeyKey); err != nil && !errors.Is(err, keyring.ErrKeyNotFound) {
-		return fmt.Errorf("delete API key: %w", err)
+	for _, key := range []string{apiKeyKey, oauthTokenKey} {
+		if err := s.remove(s.key(key)); err != nil {
+			return fmt.Errorf("delete credential: %w", err)
+		}
 	}
 
 	return nil
 }
 
+// remove deletes an item, treating a missing item as success. The file
+// backend reports missing items as os.ErrNotExist rather than ErrKeyNotFound.
+func (s *KeyringStore) remove(key string) error {
+	err := s.ring.Remove(key)
+	if err == nil || errors.Is(err, keyring.ErrKeyNotFound) || errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+// HasKey reports whether any credential is stored.
 func (s *KeyringStore) HasKey() (bool, error) {
-	_, err := s.ring.Get(apiKeyKey)
+	_, err := s.GetCredential()
 	if err != nil {
 		if errors.Is(err, keyring.ErrKeyNotFound) {
 			return false, nil
@@ -1,7 +1,13 @@
 package secrets
 
 import (
+	"errors"
+	"slices"
 	"testing"
+
+	"github.com/99designs/keyring"
+
+	"github.com/builtbyrobben/clickup-cli/internal/config"
 )
 
 func TestNormalizeKeyringBackend(t *testing.T) {
@@ -141,3 +147,151 @@ func TestShouldForceFileBackend(t *testing.T) {
 		})
 	}
 }
+
+func TestKeyringStore_CredentialKinds(t *testing.T) {
+	store := &KeyringStore{ring: keyring.NewArrayKeyring(nil)}
+
+	if has, err := store.HasKey(); err != nil || has {
+		t.Fatalf("HasKey() = %v, %v, want false, nil", has, err)
+	}
+
+	if err := store.SetAPIKey("pk_123"); err != nil {
+		t.Fatalf("SetAPIKey() error = %v", err)
+	}
+
+	cred, err := store.GetCredential()
+	if err != nil || cred.Kind != CredentialAPIKey || cred.Token != "pk_123" {
+		t.Fatalf("GetCredential() = %+v, %v, want api_key pk_123", cred, err)
+	}
+
+	if err := store.SetOAuthToken("oauth-abc"); err != nil {
+		t.Fatalf("SetOAuthToken() error = %v", err)
+	}
+
+	cred, err = store.GetCredential()
+	if err != nil || cred.Kind != CredentialOAuth || cred.Token != "oauth-abc" {
+		t.Fatalf("GetCredential() = %+v, %v, want oauth oauth-abc", cred, err)
+	}
+
+	if key, _ := store.GetAPIKey(); key != "oauth-abc" {
+		t.Errorf("GetAPIKey() = %q, want the active OAuth token", key)
+	}
+
+	if err := store.SetAPIKey("pk_456"); err != nil {
+		t.Fatalf("SetAPIKey() error = %v", err)
+	}
+
+	if cred, _ := store.GetCredential(); cred.Kind != CredentialAPIKey || cred.Token != "pk_456" {
+		t.Errorf("GetCredential() = %+v, want API key to replace OAuth token", cred)
+	}
+
+	if err := store.DeleteAPIKey(); err != nil {
+		t.Fatalf("DeleteAPIKey() error = %v", err)
+	}
+
+	if has, _ := store.HasKey(); has {
+		t.Error("HasKey() = true after DeleteAPIKey()")
+	}
+}
+
+func TestKeyringStore_ProfilesAreIsolated(t *testing.T) {
+	ring := keyring.NewArrayKeyring(nil)
+	work := &KeyringStore{ring: ring, profile: "work"}
+	home := &KeyringStore{ring: ring, profile: "home"}
+
+	if err := work.SetAPIKey("pk_work"); err != nil {
+		t.Fatalf("SetAPIKey() error = %v", err)
+	}
+
+	if has, _ := home.HasKey(); has {
+		t.Error("HasKey() = true for a profile without credentials")
+	}
+
+	if key, _ := work.GetAPIKey(); key != "pk_work" {
+		t.Errorf("GetAPIKey() = %q, want pk_work", key)
+	}
+
+	if _, err := ring.Get("api_key@work"); err != nil {
+		t.Errorf("expected profile-scoped keyring item, got %v", err)
+	}
+}
+
+// useTempConfig points the config directory at a temporary one, since
+// migrating legacy credentials records the default profile there.
+func useTempConfig(t *testing.T) {
+	t.Helper()
+
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("APPDATA", dir)
+}
+
+func TestKeyringStore_MigratesLegacyCredential(t *testing.T) {
+	useTempConfig(t)
+
+	ring := keyring.NewArrayKeyring([]keyring.Item{{Key: apiKeyKey, Data: []byte("pk_legacy")}})
+	store := &KeyringStore{ring: ring, profile: "default"}
+
+	if err := store.migrateLegacy(); err != nil {
+		t.Fatalf("migrateLegacy() error = %v", err)
+	}
+
+	cred, err := store.GetCredential()
+	if err != nil || cred.Token != "pk_legacy" || cred.Kind != CredentialAPIKey {
+		t.Fatalf("GetCredential() = %+v, %v, want migrated pk_legacy", cred, err)
+	}
+
+	if _, err := ring.Get(apiKeyKey); !errors.Is(err, keyring.ErrKeyNotFound) {
+		t.Errorf("expected legacy item to be removed, got %v", err)
+	}
+
+	// A key saved before profiles, with no team or workspace ID, still
+	// leaves a listed default profile.
+	if profiles, err := config.ListProfiles(); err != nil || !slices.Equal(profiles, []string{config.DefaultProfile}) {
+		t.Errorf("ListProfiles() = %v, %v, want the default profile", profiles, err)
+	}
+}
+
+func TestKeyringStore_MigrationKeepsProfileCredential(t *testing.T) {
+	useTempConfig(t)
+
+	ring := keyring.NewArrayKeyring([]keyring.Item{
+		{Key: oauthTokenKey, Data: []byte("oauth-legacy")},
+		{Key: apiKeyKey + "@default", Data: []byte("pk_profile")},
+		{Key: apiKeyKey, Data: []byte("pk_legacy")},
+	})
+	store := &KeyringStore{ring: ring, profile: "default"}
+
+	if err := store.migrateLegacy(); err != nil {
+		t.Fatalf("migrateLegacy() error = %v", err)
+	}
+
+	if item, err := ring.Get(apiKeyKey + "@default"); err != nil || string(item.Data) != "pk_profile" {
+		t.Errorf("expected the profile's own API key to be kept, got %q, %v", item.Data, err)
+	}
+
+	cred, err := store.GetCredential()
+	if err != nil || cred.Kind != CredentialOAuth || cred.Token != "oauth-legacy" {
+		t.Fatalf("GetCredential() = %+v, %v, want migrated oauth-legacy", cred, err)
+	}
+
+	if _, err := ring.Get(oauthTokenKey); !errors.Is(err, keyring.ErrKeyNotFound) {
+		t.Errorf("expected legacy OAuth item to be removed, got %v", err)
+	}
+}
+
+func TestKeyringStore_NothingToMigrateLeavesConfigAlone(t *testing.T) {
+	useTempConfig(t)
+
+	ring := keyring.NewArrayKeyring(nil)
+	store := &KeyringStore{ring: ring, profile: config.DefaultProfile}
+
+	if err := store.migrateLegacy(); err != nil {
+		t.Fatalf("migrateLegacy() error = %v", err)
+	}
+
+	if profiles, err := config.ListProfiles(); err != nil || len(profiles) != 0 {
+		t.Fatalf("ListProfiles() = %v, %v, want no profiles", profiles, err)
+	}
+}
@@ -0,0 +1,346 @@
+// Package taskedit renders a task as a Markdown document with YAML front
+// matter for editing, parses the edited document back and works out which
+// fields changed.
+package taskedit
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/builtbyrobben/clickup-cli/internal/clickup"
+	"github.com/builtbyrobben/clickup-cli/internal/dateparse"
+)
+
+const (
+	delimiter  = "---"
+	dateLayout = "2006-01-02"
+	timeLayout = "2006-01-02 15:04"
+)
+
+// editableFieldTypes are the custom field types whose values round-trip
+// through text. Other fields are left out of the document.
+var editableFieldTypes = []string{
+	"text", "short_text", "number", "currency", "drop_down", "labels",
+	"checkbox", "date", "url", "email", "phone", "emoji",
+}
+
+var priorityNames = []string{"", "urgent", "high", "normal", "low"}
+
+// Document is the editable form of a task.
+type Document struct {
+	Name      string            `yaml:"name"`
+	Status    string            `yaml:"status"`
+	Priority  string            `yaml:"priority"`
+	Assignees []string          `yaml:"assignees,flow"`
+	Tags      []string          `yaml:"tags,flow"`
+	Due       string            `yaml:"due"`
+	Fields    map[string]string `yaml:"fields,omitempty"`
+
+	Description string `yaml:"-"`
+}
+
+// FromTask builds the document for a task, showing dates in loc. Assignees
+// are listed by email where the API returned one and by username otherwise.
+func FromTask(task *clickup.Task, loc *time.Location) Document {
+	doc := Document{
+		Name:        task.Name,
+		Status:      task.Status.Status,
+		Assignees:   []string{},
+		Tags:        []string{},
+		Due:         formatDate(task.DueDate, loc),
+		Description: task.MarkdownDescription,
+	}
+
+	if doc.Description == "" {
+		doc.Description = task.Description
+	}
+
+	if task.Priority != nil {
+		doc.Priority = task.Priority.Name
+	}
+
+	for _, u := range task.Assignees {
+		if u.Email != "" {
+			doc.Assignees = append(doc.Assignees, u.Email)
+		} else {
+			doc.Assignees = append(doc.Assignees, u.Username)
+		}
+	}
+
+	for _, tag := range task.Tags {
+		doc.Tags = append(doc.Tags, tag.Name)
+	}
+
+	for _, f := range task.CustomFields {
+		if !slices.Contains(editableFieldTypes, f.Type) {
+			continue
+		}
+
+		if doc.Fields == nil {
+			doc.Fields = map[string]string{}
+		}
+
+		value := f.Text()
+		if f.Type == "date" {
+			value = formatDate(value, loc)
+		}
+
+		doc.Fields[f.Name] = value
+	}
+
+	return doc
+}
+
+func formatDate(ms string, loc *time.Location) string {
+	n, err := strconv.ParseInt(ms, 10, 64)
+	if err != nil || ms == "" {
+		return ms
+	}
+
+	t := time.UnixMilli(n).In(loc)
+	if t.Hour() == 0 && t.Minute() == 0 {
+		return t.Format(dateLayout)
+	}
+
+	return t.Format(timeLayout)
+}
+
+// Render writes the document as front matter followed by the description.
+func Render(doc Document) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString(delimiter + "\n")
+	buf.WriteString("# Change any value, then save and close the editor. Empty a value to clear it; name and status cannot be empty.\n")
+	buf.WriteString("# priority: urgent, high, normal or low. due: a date such as 2026-10-20 17:00 or \"next friday\".\n")
+
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+
+	if err := enc.Encode(doc); err != nil {
+		return nil, fmt.Errorf("render task: %w", err)
+	}
+
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("render task: %w", err)
+	}
+
+	buf.WriteString(delimiter + "\n\n")
+	buf.WriteString(strings.TrimRight(doc.Description, "\n"))
+	buf.WriteString("\n")
+
+	return buf.Bytes(), nil
+}
+
+// Parse reads a document written by Render and edited by a person.
+func Parse(data []byte) (Document, error) {
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+
+	rest, ok := strings.CutPrefix(text, delimiter+"\n")
+	if !ok {
+		return Document{}, errors.New("the document must start with a --- line")
+	}
+
+	front, body, ok := strings.Cut(rest, "\n"+delimiter+"\n")
+	if !ok {
+		front, ok = strings.CutSuffix(rest, "\n"+delimiter)
+		if !ok {
+			return Document{}, errors.New("the front matter must end with a --- line")
+		}
+	}
+
+	var doc Document
+	if err := yaml.Unmarshal([]byte(front), &doc); err != nil {
+		return Document{}, fmt.Errorf("front matter: %w", err)
+	}
+
+	doc.Description = strings.TrimSpace(body)
+
+	return doc, nil
+}
+
+// Diff lists what changed between the document as rendered and as edited.
+// Nil pointers and empty slices mean unchanged.
+type Diff struct {
+	Name        *string
+	Status      *string
+	Priority    *int   // 0 clears the priority
+	Due         *int64 // 0 clears the due date
+	DueTime     bool   // Due has a time of day
+	Description *string
+
+	AddAssignees    []string
+	RemoveAssignees []string
+	AddTags         []string
+	RemoveTags      []string
+
+	// SetFields maps custom field names to NAME=VALUE assignments; ClearFields
+	// names fields that were emptied.
+	SetFields   []string
+	ClearFields []string
+}
+
+// Empty reports whether nothing changed.
+func (d Diff) Empty() bool {
+	return len(d.Changed()) == 0
+}
+
+// Changed names the changed parts of the task, in document order.
+func (d Diff) Changed() []string {
+	var changed []string
+
+	add := func(cond bool, name string) {
+		if cond {
+			changed = append(changed, name)
+		}
+	}
+
+	add(d.Name != nil, "name")
+	add(d.Status != nil, "status")
+	add(d.Priority != nil, "priority")
+	add(len(d.AddAssignees)+len(d.RemoveAssignees) > 0, "assignees")
+	add(len(d.AddTags)+len(d.RemoveTags) > 0, "tags")
+	add(d.Due != nil, "due")
+
+	for _, f := range d.SetFields {
+		name, _, _ := strings.Cut(f, "=")
+		changed = append(changed, "fields."+name)
+	}
+
+	for _, name := range d.ClearFields {
+		changed = append(changed, "fields."+name)
+	}
+
+	add(d.Description != nil, "description")
+
+	return changed
+}
+
+// Compare works out the changes from before to after. Dates are read in
+// now's location; relative dates are relative to now.
+func Compare(before, after Document, now time.Time) (Diff, error) {
+	var d Diff
+
+	if after.Name != before.Name {
+		if strings.TrimSpace(after.Name) == "" {
+			return d, errors.New("name cannot be empty")
+		}
+
+		d.Name = &after.Name
+	}
+
+	if after.Status != before.Status {
+		if after.Status == "" {
+			return d, errors.New("status cannot be empty")
+		}
+
+		d.Status = &after.Status
+	}
+
+	if !strings.EqualFold(after.Priority, before.Priority) {
+		// An empty priority is index 0, which clears it.
+		p := slices.Index(priorityNames, strings.ToLower(strings.TrimSpace(after.Priority)))
+		if p < 0 {
+			return d, fmt.Errorf("priority %q: expected urgent, high, normal or low", after.Priority)
+		}
+
+		d.Priority = &p
+	}
+
+	if after.Due != before.Due {
+		var ms int64
+
+		if after.Due != "" {
+			t, err := dateparse.Parse(after.Due, now)
+			if err != nil {
+				return d, fmt.Errorf("due %q: %w", after.Due, err)
+			}
+
+			ms = t.UnixMilli()
+			d.DueTime = t.Hour() != 0 || t.Minute() != 0 || t.Second() != 0
+		}
+
+		d.Due = &ms
+	}
+
+	if after.Description != strings.TrimSpace(before.Description) {
+		d.Description = &after.Description
+	}
+
+	d.AddAssignees, d.RemoveAssignees = setDiff(before.Assignees, after.Assignees)
+	d.AddTags, d.RemoveTags = setDiff(before.Tags, after.Tags)
+
+	names := make([]string, 0, len(after.Fields))
+	for name := range after.Fields {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		old, known := before.Fields[name]
+		value := strings.TrimSpace(after.Fields[name])
+
+		switch {
+		case !known:
+			return d, fmt.Errorf("fields.%s: no such custom field on this task", name)
+		case value == strings.TrimSpace(old):
+			continue
+		case value == "":
+			d.ClearFields = append(d.ClearFields, name)
+		default:
+			d.SetFields = append(d.SetFields, name+"="+value)
+		}
+	}
+
+	return d, nil
+}
+
+// ResolveDates turns date custom field assignments into Unix milliseconds.
+func (d *Diff) ResolveDates(fields []clickup.CustomField, now time.Time) error {
+	for i, assignment := range d.SetFields {
+		name, value, _ := strings.Cut(assignment, "=")
+
+		field, err := clickup.FindCustomField(fields, name)
+		if err != nil || field.Type != "date" {
+			continue
+		}
+
+		t, err := dateparse.Parse(value, now)
+		if err != nil {
+			return fmt.Errorf("fields.%s %q: %w", name, value, err)
+		}
+
+		d.SetFields[i] = name + "=" + strconv.FormatInt(t.UnixMilli(), 10)
+	}
+
+	return nil
+}
+
+// setDiff returns the items of after missing from before and the items of
+// before missing from after, compared case-insensitively.
+func setDiff(before, after []string) (added, removed []string) {
+	has := func(list []string, s string) bool {
+		return slices.ContainsFunc(list, func(v string) bool { return strings.EqualFold(v, s) })
+	}
+
+	for _, s := range after {
+		if s = strings.TrimSpace(s); s != "" && !has(before, s) {
+			added = append(added, s)
+		}
+	}
+
+	for _, s := range before {
+		if !has(after, s) {
+			removed = append(removed, s)
+		}
+	}
+
+	return added, removed
+}
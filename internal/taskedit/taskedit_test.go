@@ -0,0 +1,168 @@
+package taskedit
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/builtbyrobben/clickup-cli/internal/clickup"
+)
+
+func testTask(t *testing.T) *clickup.Task {
+	t.Helper()
+
+	var task clickup.Task
+	if err := json.Unmarshal([]byte(`{
+		"id": "86abc",
+		"name": "Write API",
+		"markdown_description": "Some **notes**\n",
+		"status": {"status": "in progress"},
+		"priority": {"id": "2", "priority": "high"},
+		"due_date": "1792454400000",
+		"assignees": [{"id": 7, "username": "ada", "email": "ada@example.com"}, {"id": 8, "username": "bob"}],
+		"tags": [{"name": "api"}],
+		"custom_fields": [
+			{"id": "f-points", "name": "Story Points", "type": "number", "value": 5},
+			{"id": "f-team", "name": "Team", "type": "drop_down", "value": 0, "type_config": {"options": [{"id": "o-be", "name": "Backend", "orderindex": 0}]}},
+			{"id": "f-owner", "name": "Owner", "type": "users", "value": [{"id": 7}]}
+		]
+	}`), &task); err != nil {
+		t.Fatal(err)
+	}
+
+	return &task
+}
+
+func TestRender_RoundTrips(t *testing.T) {
+	t.Parallel()
+
+	doc := FromTask(testTask(t), time.UTC)
+
+	if doc.Due != "2026-10-20" || doc.Priority != "high" {
+		t.Fatalf("unexpected due or priority in %+v", doc)
+	}
+
+	if !slices.Equal(doc.Assignees, []string{"ada@example.com", "bob"}) {
+		t.Fatalf("unexpected assignees %v", doc.Assignees)
+	}
+
+	if _, ok := doc.Fields["Owner"]; ok || doc.Fields["Team"] != "Backend" || doc.Fields["Story Points"] != "5" {
+		t.Fatalf("unexpected fields %v", doc.Fields)
+	}
+
+	data, err := Render(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(data), "assignees: [ada@example.com, bob]") {
+		t.Fatalf("expected flow-style assignees, got:\n%s", data)
+	}
+
+	parsed, err := Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := Compare(doc, parsed, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !diff.Empty() {
+		t.Fatalf("expected no changes after a round trip, got %v", diff.Changed())
+	}
+}
+
+func TestCompare_FindsChanges(t *testing.T) {
+	t.Parallel()
+
+	before := FromTask(testTask(t), time.UTC)
+
+	data, err := Render(before)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	edited := strings.NewReplacer(
+		"priority: high", "priority: urgent",
+		"[ada@example.com, bob]", "[ada@example.com, cy@example.com]",
+		"tags: [api]", "tags: [backend]",
+		"Story Points: \"5\"", "Story Points: \"8\"",
+		"Team: Backend", "Team: \"\"",
+		"due: \"2026-10-20\"", "due: 2026-10-22 17:00",
+		"Some **notes**", "Other notes",
+	).Replace(string(data))
+
+	after, err := Parse([]byte(edited))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := Compare(before, after, time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"priority", "assignees", "tags", "due", "fields.Story Points", "fields.Team", "description"}
+	if got := diff.Changed(); !slices.Equal(got, want) {
+		t.Fatalf("expected %v, got %v\n%s", want, got, edited)
+	}
+
+	if *diff.Priority != 1 || !diff.DueTime || *diff.Due != time.Date(2026, 10, 22, 17, 0, 0, 0, time.UTC).UnixMilli() {
+		t.Fatalf("unexpected priority or due in %+v", diff)
+	}
+
+	if !slices.Equal(diff.AddAssignees, []string{"cy@example.com"}) || !slices.Equal(diff.RemoveAssignees, []string{"bob"}) {
+		t.Fatalf("unexpected assignee changes %+v", diff)
+	}
+
+	if !slices.Equal(diff.SetFields, []string{"Story Points=8"}) || !slices.Equal(diff.ClearFields, []string{"Team"}) {
+		t.Fatalf("unexpected field changes %+v", diff)
+	}
+}
+
+func TestCompare_EmptyValuesClear(t *testing.T) {
+	t.Parallel()
+
+	before := FromTask(testTask(t), time.UTC)
+	after := before
+	after.Priority = ""
+	after.Due = ""
+
+	diff, err := Compare(before, after, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff.Priority == nil || *diff.Priority != 0 || diff.Due == nil || *diff.Due != 0 {
+		t.Fatalf("expected priority and due to be cleared, got %+v", diff)
+	}
+}
+
+func TestCompare_Errors(t *testing.T) {
+	t.Parallel()
+
+	before := FromTask(testTask(t), time.UTC)
+	now := time.Now()
+
+	for name, edit := range map[string]func(d *Document){
+		"empty name":    func(d *Document) { d.Name = " " },
+		"bad priority":  func(d *Document) { d.Priority = "soon" },
+		"bad due":       func(d *Document) { d.Due = "someday" },
+		"unknown field": func(d *Document) { d.Fields = map[string]string{"Nope": "1"} },
+	} {
+		after := before
+		edit(&after)
+
+		if _, err := Compare(before, after, now); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	if _, err := Parse([]byte("name: x\n")); err == nil {
+		t.Error("expected an error for a document without front matter")
+	}
+}
@@ -0,0 +1,118 @@
+package taskimport
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// State records which rows an import has created, so that an interrupted
+// import can be run again and pick up where it stopped.
+type State struct {
+	List    string            `json:"list"`
+	Created map[string]string `json:"created"`
+
+	path string
+}
+
+// LoadState reads the state file at path. A missing file is an empty state.
+func LoadState(path string) (*State, error) {
+	s := &State{Created: map[string]string{}, path: path}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("read import state: %w", err)
+	}
+
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("read import state %s: %w", path, err)
+	}
+
+	if s.Created == nil {
+		s.Created = map[string]string{}
+	}
+
+	return s, nil
+}
+
+// Record notes that the row with key was created as taskID and saves the
+// state, replacing the file atomically.
+func (s *State) Record(key, taskID string) error {
+	s.Created[key] = taskID
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), ".import-state-*")
+	if err != nil {
+		return fmt.Errorf("write import state: %w", err)
+	}
+
+	_, writeErr := tmp.Write(append(data, '\n'))
+	closeErr := tmp.Close()
+
+	if err := errors.Join(writeErr, closeErr); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("write import state: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("write import state: %w", err)
+	}
+
+	return nil
+}
+
+// Result maps an input row's external key to the task created for it.
+type Result struct {
+	Key    string `json:"key"`
+	TaskID string `json:"task_id"`
+	Line   int    `json:"line"`
+}
+
+// WriteResults writes results as a JSON array when path ends in .json and as
+// CSV otherwise.
+func WriteResults(path string, results []Result) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("write import results: %w", err)
+	}
+
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		enc := json.NewEncoder(f)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(results)
+	} else {
+		w := csv.NewWriter(f)
+		_ = w.Write([]string{"key", "task_id", "line"})
+
+		for _, r := range results {
+			_ = w.Write([]string{r.Key, r.TaskID, fmt.Sprint(r.Line)})
+		}
+
+		w.Flush()
+		err = w.Error()
+	}
+
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return fmt.Errorf("write import results: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,487 @@
+// Package taskimport turns rows of a CSV or JSON file into ClickUp task
+// create requests. A mapping sends columns to task attributes; rows are
+// validated as a whole before anything is created and ordered so that every
+// parent is created before its subtasks.
+package taskimport
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/builtbyrobben/clickup-cli/internal/clickup"
+	"github.com/builtbyrobben/clickup-cli/internal/dateparse"
+)
+
+// Mapping targets. Custom fields are mapped as FieldPrefix + field name.
+const (
+	TargetKey         = "key"
+	TargetName        = "name"
+	TargetDescription = "description"
+	TargetStatus      = "status"
+	TargetAssignee    = "assignee"
+	TargetTags        = "tags"
+	TargetDue         = "due"
+	TargetPriority    = "priority"
+	TargetParent      = "parent"
+
+	FieldPrefix = "field:"
+)
+
+var targets = []string{
+	TargetKey, TargetName, TargetDescription, TargetStatus, TargetAssignee,
+	TargetTags, TargetDue, TargetPriority, TargetParent,
+}
+
+var priorities = map[string]int{"urgent": 1, "high": 2, "normal": 3, "low": 4}
+
+// Row is one record of the input file. Line is its 1-based line (CSV) or
+// element (JSON) number, for error messages.
+type Row struct {
+	Line   int
+	Values map[string]string
+}
+
+// Read loads rows from a .json file (an array of objects) or, for any other
+// extension, a CSV file with a header row. It returns the column names in
+// file order.
+func Read(path string) ([]string, []Row, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open import file: %w", err)
+	}
+	defer f.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return ReadJSON(f)
+	}
+
+	return ReadCSV(f)
+}
+
+// ReadCSV reads a CSV file whose first record names the columns.
+func ReadCSV(r io.Reader) ([]string, []Row, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("read csv: %w", err)
+	}
+
+	if len(records) == 0 {
+		return nil, nil, errors.New("read csv: no header row")
+	}
+
+	headers := records[0]
+	if len(headers) > 0 {
+		headers[0] = strings.TrimPrefix(headers[0], "\ufeff")
+	}
+
+	rows := make([]Row, 0, len(records)-1)
+
+	for i, record := range records[1:] {
+		values := make(map[string]string, len(headers))
+		for j, h := range headers {
+			if j < len(record) {
+				values[h] = strings.TrimSpace(record[j])
+			}
+		}
+
+		rows = append(rows, Row{Line: i + 2, Values: values})
+	}
+
+	return headers, rows, nil
+}
+
+// ReadJSON reads an array of objects. Arrays of scalars become
+// comma-separated text; other nested values are kept as JSON.
+func ReadJSON(r io.Reader) ([]string, []Row, error) {
+	var records []map[string]any
+	if err := json.NewDecoder(r).Decode(&records); err != nil {
+		return nil, nil, fmt.Errorf("read json: %w", err)
+	}
+
+	var headers []string
+
+	seen := map[string]bool{}
+	rows := make([]Row, 0, len(records))
+
+	for i, record := range records {
+		values := make(map[string]string, len(record))
+
+		keys := make([]string, 0, len(record))
+		for k := range record {
+			keys = append(keys, k)
+		}
+
+		slices.Sort(keys)
+
+		for _, k := range keys {
+			values[k] = jsonText(record[k])
+
+			if !seen[k] {
+				seen[k] = true
+				headers = append(headers, k)
+			}
+		}
+
+		rows = append(rows, Row{Line: i + 1, Values: values})
+	}
+
+	return headers, rows, nil
+}
+
+func jsonText(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.TrimSpace(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case []any:
+		parts := make([]string, 0, len(v))
+
+		for _, item := range v {
+			switch item.(type) {
+			case map[string]any, []any:
+				data, _ := json.Marshal(v)
+				return string(data)
+			}
+
+			parts = append(parts, jsonText(item))
+		}
+
+		return strings.Join(parts, ",")
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
+
+// Mapping sends task attributes (the keys: key, name, description, status,
+// assignee, tags, due, priority, parent, or field:NAME) to input columns.
+type Mapping map[string]string
+
+// ParseMapping reads TARGET=COLUMN specs.
+func ParseMapping(specs []string) (Mapping, error) {
+	m := Mapping{}
+
+	for _, spec := range specs {
+		target, column, ok := strings.Cut(spec, "=")
+		target = strings.TrimSpace(target)
+
+		if !ok || target == "" || strings.TrimSpace(column) == "" {
+			return nil, fmt.Errorf("mapping %q: expected TARGET=COLUMN", spec)
+		}
+
+		if !strings.HasPrefix(strings.ToLower(target), FieldPrefix) {
+			target = strings.ToLower(target)
+			if !slices.Contains(targets, target) {
+				return nil, fmt.Errorf("mapping %q: unknown target %q (expected one of %s, or field:NAME)", spec, target, strings.Join(targets, ", "))
+			}
+		} else {
+			target = FieldPrefix + strings.TrimSpace(target[len(FieldPrefix):])
+		}
+
+		m[target] = strings.TrimSpace(column)
+	}
+
+	return m, nil
+}
+
+// Complete maps every unmapped target to a column of the same name, compared
+// case-insensitively, and checks that all mapped columns exist.
+func (m Mapping) Complete(headers []string) error {
+	for _, target := range targets {
+		if _, ok := m[target]; ok {
+			continue
+		}
+
+		for _, h := range headers {
+			if strings.EqualFold(strings.TrimSpace(h), target) {
+				m[target] = h
+				break
+			}
+		}
+	}
+
+	for target, column := range m {
+		if !slices.Contains(headers, column) {
+			return fmt.Errorf("mapping %s=%s: no such column (columns: %s)", target, column, strings.Join(headers, ", "))
+		}
+	}
+
+	if _, ok := m[TargetName]; !ok {
+		return errors.New("no column is mapped to name")
+	}
+
+	return nil
+}
+
+// Options supply what Build needs to turn text into task attributes.
+type Options struct {
+	// Fields are the custom fields of the target list.
+	Fields []clickup.CustomField
+	// User resolves an assignee (an email, @username or ID) to a user ID.
+	User func(ref string) (int, error)
+	// Now anchors relative dates; dates without a zone use its location.
+	Now time.Time
+}
+
+// Task is a row ready to create. ParentKey names the row to create it under.
+type Task struct {
+	Key       string
+	ParentKey string
+	Line      int
+	Request   clickup.CreateTaskRequest
+}
+
+// RowError is a validation error of one input row.
+type RowError struct {
+	Line int
+	Err  error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("row %d: %v", e.Line, e.Err)
+}
+
+func (e *RowError) Unwrap() error { return e.Err }
+
+// Build validates every row and returns the tasks in creation order, parents
+// before their subtasks. Rows without a key are keyed "row:LINE". All row
+// errors are returned together; the tasks are only usable when there are none.
+func Build(rows []Row, m Mapping, opts Options) ([]Task, []error) {
+	var errs []error
+
+	tasks := make([]Task, 0, len(rows))
+	keys := map[string]int{}
+
+	for _, row := range rows {
+		task, err := buildTask(row, m, opts)
+		if err != nil {
+			errs = append(errs, &RowError{Line: row.Line, Err: err})
+			continue
+		}
+
+		if line, dup := keys[task.Key]; dup {
+			errs = append(errs, &RowError{Line: row.Line, Err: fmt.Errorf("key %q is already used by row %d", task.Key, line)})
+			continue
+		}
+
+		keys[task.Key] = row.Line
+		tasks = append(tasks, task)
+	}
+
+	for _, task := range tasks {
+		if task.ParentKey != "" {
+			if _, ok := keys[task.ParentKey]; !ok {
+				errs = append(errs, &RowError{Line: task.Line, Err: fmt.Errorf("parent %q matches no row's key", task.ParentKey)})
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	ordered, err := order(tasks)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	return ordered, nil
+}
+
+func buildTask(row Row, m Mapping, opts Options) (Task, error) {
+	get := func(target string) string {
+		if column, ok := m[target]; ok {
+			return row.Values[column]
+		}
+
+		return ""
+	}
+
+	task := Task{
+		Key:       get(TargetKey),
+		ParentKey: get(TargetParent),
+		Line:      row.Line,
+	}
+
+	if task.Key == "" {
+		task.Key = "row:" + strconv.Itoa(row.Line)
+	}
+
+	req := clickup.CreateTaskRequest{
+		Name:        get(TargetName),
+		Description: get(TargetDescription),
+		Status:      get(TargetStatus),
+		Tags:        splitList(get(TargetTags)),
+	}
+
+	if req.Name == "" {
+		return task, errors.New("name is empty")
+	}
+
+	for _, ref := range splitList(get(TargetAssignee)) {
+		if opts.User == nil {
+			return task, fmt.Errorf("assignee %q: no user lookup", ref)
+		}
+
+		id, err := opts.User(ref)
+		if err != nil {
+			return task, fmt.Errorf("assignee %q: %w", ref, err)
+		}
+
+		req.Assignees = append(req.Assignees, id)
+	}
+
+	if due := get(TargetDue); due != "" {
+		t, err := dateparse.Parse(due, opts.Now)
+		if err != nil {
+			return task, fmt.Errorf("due %q: %w", due, err)
+		}
+
+		req.DueDate = t.UnixMilli()
+		req.DueDateTime = t.Hour() != 0 || t.Minute() != 0 || t.Second() != 0
+	}
+
+	if p := get(TargetPriority); p != "" {
+		priority, err := parsePriority(p)
+		if err != nil {
+			return task, err
+		}
+
+		req.Priority = &priority
+	}
+
+	var assignments []string
+
+	for target, column := range m {
+		name, ok := strings.CutPrefix(target, FieldPrefix)
+		if !ok || row.Values[column] == "" {
+			continue
+		}
+
+		assignments = append(assignments, name+"="+row.Values[column])
+	}
+
+	slices.Sort(assignments)
+
+	values, err := clickup.CustomFieldValues(opts.Fields, assignments)
+	if err != nil {
+		return task, err
+	}
+
+	if len(values) > 0 {
+		req.CustomFields = values
+	}
+
+	task.Request = req
+
+	return task, nil
+}
+
+// CheckFields reports mapped custom fields that the list does not have, so a
+// bad mapping fails even when every row leaves the column empty.
+func (m Mapping) CheckFields(fields []clickup.CustomField) error {
+	for target := range m {
+		name, ok := strings.CutPrefix(target, FieldPrefix)
+		if !ok {
+			continue
+		}
+
+		if _, err := clickup.FindCustomField(fields, name); err != nil {
+			return fmt.Errorf("mapping %s: %w", target, err)
+		}
+	}
+
+	return nil
+}
+
+func parsePriority(s string) (int, error) {
+	if p, ok := priorities[strings.ToLower(s)]; ok {
+		return p, nil
+	}
+
+	p, err := strconv.Atoi(s)
+	if err != nil || p < 1 || p > 4 {
+		return 0, fmt.Errorf("priority %q: expected 1-4 or urgent, high, normal, low", s)
+	}
+
+	return p, nil
+}
+
+// splitList splits comma- or semicolon-separated values.
+func splitList(s string) []string {
+	var out []string
+
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ';' }) {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+
+	return out
+}
+
+// order sorts tasks so that parents come before their subtasks, keeping the
+// file order otherwise.
+func order(tasks []Task) ([]Task, error) {
+	byKey := make(map[string]Task, len(tasks))
+	for _, task := range tasks {
+		byKey[task.Key] = task
+	}
+
+	const (
+		visiting = 1
+		done     = 2
+	)
+
+	state := map[string]int{}
+	ordered := make([]Task, 0, len(tasks))
+
+	var visit func(task Task) error
+
+	visit = func(task Task) error {
+		switch state[task.Key] {
+		case done:
+			return nil
+		case visiting:
+			return &RowError{Line: task.Line, Err: fmt.Errorf("parent chain of %q loops back to itself", task.Key)}
+		}
+
+		state[task.Key] = visiting
+
+		if task.ParentKey != "" {
+			if err := visit(byKey[task.ParentKey]); err != nil {
+				return err
+			}
+		}
+
+		state[task.Key] = done
+		ordered = append(ordered, task)
+
+		return nil
+	}
+
+	for _, task := range tasks {
+		if err := visit(task); err != nil {
+			return nil, err
+		}
+	}
+
+	return ordered, nil
+}
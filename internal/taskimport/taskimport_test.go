@@ -0,0 +1,195 @@
+package taskimport
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/builtbyrobben/clickup-cli/internal/clickup"
+)
+
+func testFields(t *testing.T) []clickup.CustomField {
+	t.Helper()
+
+	var fields []clickup.CustomField
+	if err := json.Unmarshal([]byte(`[
+		{"id":"f-points","name":"Story Points","type":"number"},
+		{"id":"f-team","name":"Team","type":"drop_down","type_config":{"options":[{"id":"o-be","name":"Backend"}]}}
+	]`), &fields); err != nil {
+		t.Fatal(err)
+	}
+
+	return fields
+}
+
+func testOptions(t *testing.T) Options {
+	t.Helper()
+
+	return Options{
+		Fields: testFields(t),
+		User: func(ref string) (int, error) {
+			if ref == "ada@example.com" {
+				return 7, nil
+			}
+
+			return 0, fmt.Errorf("no user %q", ref)
+		},
+		Now: time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestBuild_MapsColumnsAndOrdersParentsFirst(t *testing.T) {
+	t.Parallel()
+
+	headers, rows, err := ReadCSV(strings.NewReader(
+		"Issue,Title,Parent,Assignee,Labels,Due,Priority,Points,Team\n" +
+			"ENG-2,Write API,ENG-1,ada@example.com,\"api, backend\",2026-10-20,high,5,backend\n" +
+			"ENG-1,Epic,,,,,,,\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := ParseMapping([]string{"key=Issue", "name=Title", "tags=Labels", "field:Story Points=Points", "field:Team=Team"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.Complete(headers); err != nil {
+		t.Fatal(err)
+	}
+
+	if m[TargetParent] != "Parent" || m[TargetPriority] != "Priority" {
+		t.Fatalf("expected same-name columns to be mapped, got %v", m)
+	}
+
+	tasks, errs := Build(rows, m, testOptions(t))
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	if len(tasks) != 2 || tasks[0].Key != "ENG-1" || tasks[1].ParentKey != "ENG-1" {
+		t.Fatalf("expected the parent first, got %+v", tasks)
+	}
+
+	req := tasks[1].Request
+	if req.Name != "Write API" || len(req.Assignees) != 1 || req.Assignees[0] != 7 {
+		t.Fatalf("unexpected request %+v", req)
+	}
+
+	if *req.Priority != 2 || req.DueDate != time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC).UnixMilli() || req.DueDateTime {
+		t.Fatalf("unexpected priority or due date in %+v", req)
+	}
+
+	if len(req.Tags) != 2 || req.Tags[1] != "backend" {
+		t.Fatalf("unexpected tags %v", req.Tags)
+	}
+
+	if len(req.CustomFields) != 2 || req.CustomFields[0].Value != float64(5) || req.CustomFields[1].Value != "o-be" {
+		t.Fatalf("unexpected custom fields %+v", req.CustomFields)
+	}
+}
+
+func TestBuild_ReportsEveryBadRow(t *testing.T) {
+	t.Parallel()
+
+	_, rows, err := ReadJSON(strings.NewReader(`[
+		{"key":"A","name":"ok"},
+		{"key":"B","name":""},
+		{"key":"C","name":"x","assignee":"nobody@example.com"},
+		{"key":"D","name":"x","due":"someday"},
+		{"key":"E","name":"x","parent":"Z"},
+		{"key":"A","name":"dup"}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := Mapping{}
+	if err := m.Complete([]string{"key", "name", "assignee", "due", "parent"}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, errs := Build(rows, m, testOptions(t))
+	if len(errs) != 5 {
+		t.Fatalf("expected 5 errors, got %v", errs)
+	}
+
+	var rowErr *RowError
+	if !errors.As(errs[0], &rowErr) || rowErr.Line != 2 {
+		t.Fatalf("expected an error for row 2 first, got %v", errs[0])
+	}
+}
+
+func TestBuild_RejectsParentLoops(t *testing.T) {
+	t.Parallel()
+
+	rows := []Row{
+		{Line: 2, Values: map[string]string{"key": "A", "name": "a", "parent": "B"}},
+		{Line: 3, Values: map[string]string{"key": "B", "name": "b", "parent": "A"}},
+	}
+
+	m := Mapping{}
+	if err := m.Complete([]string{"key", "name", "parent"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, errs := Build(rows, m, testOptions(t)); len(errs) != 1 {
+		t.Fatalf("expected a loop error, got %v", errs)
+	}
+}
+
+func TestMapping_Errors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := ParseMapping([]string{"colour=Color"}); err == nil {
+		t.Fatal("expected an unknown target error")
+	}
+
+	m, err := ParseMapping([]string{"name=Missing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.Complete([]string{"Title"}); err == nil {
+		t.Fatal("expected a missing column error")
+	}
+
+	m, err = ParseMapping([]string{"name=Title", "field:Nope=Title"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.CheckFields(testFields(t)); err == nil {
+		t.Fatal("expected an unknown custom field error")
+	}
+}
+
+func TestState_ResumesFromFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "import.state.json")
+
+	state, err := LoadState(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state.List = "list-1"
+
+	if err := state.Record("ENG-1", "86abc"); err != nil {
+		t.Fatal(err)
+	}
+
+	again, err := LoadState(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if again.List != "list-1" || again.Created["ENG-1"] != "86abc" {
+		t.Fatalf("unexpected state %+v", again)
+	}
+}